@@ -0,0 +1,130 @@
+/**
+ * Advent of Code 2025 - Known Answers Store
+ *
+ * Keeps accepted answers in a JSON file keyed by day, part and a
+ * hash of the input, so solver output can be checked for regressions.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package answers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Key identifies one answer: a part of a day solved for a specific input
+type Key struct {
+	Day   int
+	Part  int
+	Input string // HashInput of the puzzle input
+}
+
+// Entry is one answer as stored on disk
+type Entry struct {
+	Day    int    `json:"day"`
+	Part   int    `json:"part"`
+	Input  string `json:"input"`
+	Answer string `json:"answer"`
+}
+
+// Store is an in-memory view of an answers file
+type Store struct {
+	path    string
+	answers map[Key]string
+}
+
+// hashes puzzle input so answers stay tied to the input that produced them
+func HashInput(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// reads the answers file at path; a missing file gives an empty store
+func Load(path string) (*Store, error) {
+	s := &Store{path: path, answers: make(map[Key]string)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	for _, e := range entries {
+		s.answers[Key{Day: e.Day, Part: e.Part, Input: e.Input}] = e.Answer
+	}
+
+	return s, nil
+}
+
+// returns the recorded answer for key
+func (s *Store) Get(key Key) (string, bool) {
+	answer, ok := s.answers[key]
+	return answer, ok
+}
+
+// records answer for key, replacing any previous one
+func (s *Store) Set(key Key, answer string) {
+	s.answers[key] = answer
+}
+
+// returns every stored answer ordered by day, part and input
+func (s *Store) Entries() []Entry {
+	entries := make([]Entry, 0, len(s.answers))
+	for k, answer := range s.answers {
+		entries = append(entries, Entry{Day: k.Day, Part: k.Part, Input: k.Input, Answer: answer})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		if a.Part != b.Part {
+			return a.Part < b.Part
+		}
+		return a.Input < b.Input
+	})
+
+	return entries
+}
+
+// writes the store back to its file in a stable order, replacing it atomically
+func (s *Store) Save() error {
+	data, err := json.MarshalIndent(s.Entries(), "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".answers_*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
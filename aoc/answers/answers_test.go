@@ -0,0 +1,116 @@
+/**
+ * Test suite for Advent of Code 2025 - Known Answers Store
+ *
+ * Tests verify loading, saving and lookup of recorded answers.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package answers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// hash input
+func TestHashInput(t *testing.T) {
+	a := HashInput([]byte("L68\nL30\n"))
+	b := HashInput([]byte("L68\nL30\n"))
+	c := HashInput([]byte("L68\nL31\n"))
+
+	if a != b {
+		t.Errorf("HashInput not stable: %s != %s", a, b)
+	}
+	if a == c {
+		t.Errorf("HashInput gave the same hash for different inputs")
+	}
+	if len(a) != 64 {
+		t.Errorf("HashInput length = %d; expected 64 hex characters", len(a))
+	}
+}
+
+// missing file
+func TestLoadMissingFile(t *testing.T) {
+	dir, err := os.MkdirTemp("", "test_answers_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := Load(filepath.Join(dir, "answers.json"))
+	if err != nil {
+		t.Fatalf("Load of missing file unexpected error: %v", err)
+	}
+	if len(s.Entries()) != 0 {
+		t.Errorf("Load of missing file has %d entries; expected 0", len(s.Entries()))
+	}
+}
+
+// save and reload
+func TestSaveLoadRoundTrip(t *testing.T) {
+	dir, err := os.MkdirTemp("", "test_answers_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "answers.json")
+	s, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	s.Set(Key{Day: 6, Part: 2, Input: "bbb"}, "8907730960817")
+	s.Set(Key{Day: 6, Part: 1, Input: "bbb"}, "4805473544166")
+	s.Set(Key{Day: 1, Part: 1, Input: "aaa"}, "3")
+	s.Set(Key{Day: 1, Part: 1, Input: "aaa"}, "4") // replaces
+
+	if err := s.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	reloaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load after save failed: %v", err)
+	}
+
+	expected := []Entry{
+		{Day: 1, Part: 1, Input: "aaa", Answer: "4"},
+		{Day: 6, Part: 1, Input: "bbb", Answer: "4805473544166"},
+		{Day: 6, Part: 2, Input: "bbb", Answer: "8907730960817"},
+	}
+	entries := reloaded.Entries()
+	if len(entries) != len(expected) {
+		t.Fatalf("Expected %d entries, got %d", len(expected), len(entries))
+	}
+	for i, exp := range expected {
+		if entries[i] != exp {
+			t.Errorf("Entry %d: expected %+v, got %+v", i, exp, entries[i])
+		}
+	}
+
+	if _, ok := reloaded.Get(Key{Day: 1, Part: 1, Input: "other"}); ok {
+		t.Errorf("Get with a different input hash found an answer; expected none")
+	}
+}
+
+// corrupt file
+func TestLoadInvalidJSON(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "test_answers_*.json")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.WriteString("{not json"); err != nil {
+		t.Fatalf("Failed to write to temp file: %v", err)
+	}
+	tmpFile.Close()
+
+	if _, err := Load(tmpFile.Name()); err == nil {
+		t.Errorf("Load of invalid JSON expected error but got none")
+	}
+}
@@ -0,0 +1,143 @@
+/**
+ * Advent of Code 2025 - Submission Attempts Log
+ *
+ * Remembers every judged submission so a wrong answer is never sent
+ * twice, and so answers already ruled out by a "too high" or "too low"
+ * reply are rejected locally. Replies asking to wait are kept too, so
+ * nothing is sent again before the cooldown ends.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package attempts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"aoc/client"
+)
+
+// Attempt is one judged submission, or one the site asked to wait on
+type Attempt struct {
+	Day     int            `json:"day"`
+	Part    int            `json:"part"`
+	Answer  string         `json:"answer"`
+	Verdict client.Verdict `json:"verdict"`
+	Time    time.Time      `json:"time"`
+	Until   time.Time      `json:"until,omitzero"` // end of the cooldown the reply asked for
+}
+
+// Log is the attempts file loaded into memory
+type Log struct {
+	path     string
+	attempts []Attempt
+}
+
+// reads the log at path; a missing file gives an empty log
+func Load(path string) (*Log, error) {
+	l := &Log{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &l.attempts); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	return l, nil
+}
+
+// records a judged attempt
+func (l *Log) Add(a Attempt) {
+	l.attempts = append(l.attempts, a)
+}
+
+// returns every attempt for one part, oldest first
+func (l *Log) For(day, part int) []Attempt {
+	var found []Attempt
+	for _, a := range l.attempts {
+		if a.Day == day && a.Part == part {
+			found = append(found, a)
+		}
+	}
+	return found
+}
+
+// returns the accepted attempt for a part, if any
+func (l *Log) Solved(day, part int) (Attempt, bool) {
+	for _, a := range l.For(day, part) {
+		if a.Verdict == client.Correct {
+			return a, true
+		}
+	}
+	return Attempt{}, false
+}
+
+// returns when the latest cooldown for a part ends, if that is after now
+func (l *Log) CoolingDown(day, part int, now time.Time) (time.Time, bool) {
+	var until time.Time
+	for _, a := range l.For(day, part) {
+		if a.Until.After(until) {
+			until = a.Until
+		}
+	}
+	return until, until.After(now)
+}
+
+// explains why answer need not be submitted, based on earlier replies:
+// the same answer was already judged, or a previous "too high"/"too low"
+// already rules it out. Returns the attempt that decides it.
+func (l *Log) RuledOut(day, part int, answer string) (Attempt, bool) {
+	candidate, numeric := new(big.Int).SetString(answer, 10)
+
+	for _, a := range l.For(day, part) {
+		if !a.Verdict.Judged() {
+			continue
+		}
+		if a.Answer == answer {
+			return a, true
+		}
+		if !numeric {
+			continue
+		}
+
+		previous, ok := new(big.Int).SetString(a.Answer, 10)
+		if !ok {
+			continue
+		}
+		if a.Verdict == client.TooHigh && candidate.Cmp(previous) >= 0 {
+			return a, true
+		}
+		if a.Verdict == client.TooLow && candidate.Cmp(previous) <= 0 {
+			return a, true
+		}
+	}
+
+	return Attempt{}, false
+}
+
+// writes the log back to its file
+func (l *Log) Save() error {
+	data, err := json.MarshalIndent(l.attempts, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(l.path, data, 0o600)
+}
@@ -0,0 +1,130 @@
+/**
+ * Test suite for Advent of Code 2025 - Submission Attempts Log
+ *
+ * Tests verify persistence and which answers are ruled out locally.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package attempts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"aoc/client"
+)
+
+// ruled out answers
+func TestRuledOut(t *testing.T) {
+	l := &Log{}
+	l.Add(Attempt{Day: 1, Part: 1, Answer: "500", Verdict: client.TooHigh})
+	l.Add(Attempt{Day: 1, Part: 1, Answer: "100", Verdict: client.TooLow})
+	l.Add(Attempt{Day: 1, Part: 1, Answer: "250", Verdict: client.Incorrect})
+	l.Add(Attempt{Day: 1, Part: 2, Answer: "999", Verdict: client.TooHigh})
+
+	tests := []struct {
+		part     int
+		answer   string
+		expected bool
+	}{
+		{1, "250", true},   // already judged wrong
+		{1, "500", true},   // already judged too high
+		{1, "501", true},   // above a too-high answer
+		{1, "100", true},   // already judged too low
+		{1, "99", true},    // below a too-low answer
+		{1, "300", false},  // still possible
+		{1, "101", false},  // still possible
+		{1, "abc", false},  // not numeric, never seen
+		{2, "300", false},  // other part has its own history
+		{2, "1000", true},  // above part 2's too-high answer
+		{1, "499", false},  // just below the ceiling
+		{1, "1e3", false},  // not a decimal integer
+		{1, "0250", false}, // different text is a different answer
+	}
+
+	for _, test := range tests {
+		_, result := l.RuledOut(1, test.part, test.answer)
+		if result != test.expected {
+			t.Errorf("RuledOut(part %d, %q) = %v; expected %v", test.part, test.answer, result, test.expected)
+		}
+	}
+}
+
+// cooldowns end at the latest deadline, and never rule an answer out
+func TestCoolingDown(t *testing.T) {
+	now := time.Date(2025, 12, 1, 5, 0, 0, 0, time.UTC)
+	l := &Log{}
+	l.Add(Attempt{Day: 3, Part: 1, Answer: "7", Verdict: client.TooLow, Until: now.Add(-time.Minute)})
+
+	if _, ok := l.CoolingDown(3, 1, now); ok {
+		t.Errorf("CoolingDown(3, 1) = true after the wait ended")
+	}
+
+	l.Add(Attempt{Day: 3, Part: 1, Answer: "9", Verdict: client.TooSoon, Until: now.Add(5 * time.Minute)})
+
+	until, ok := l.CoolingDown(3, 1, now)
+	if !ok || !until.Equal(now.Add(5*time.Minute)) {
+		t.Errorf("CoolingDown(3, 1) = %v, %v; expected %v", until, ok, now.Add(5*time.Minute))
+	}
+	if _, ok := l.CoolingDown(3, 2, now); ok {
+		t.Errorf("CoolingDown(3, 2) = true; other part has its own history")
+	}
+	if _, ok := l.RuledOut(3, 1, "9"); ok {
+		t.Errorf("RuledOut(3, 1, 9) = true; a too-soon reply never judged it")
+	}
+}
+
+// solved part
+func TestSolved(t *testing.T) {
+	l := &Log{}
+	l.Add(Attempt{Day: 2, Part: 1, Answer: "10", Verdict: client.TooLow})
+
+	if _, ok := l.Solved(2, 1); ok {
+		t.Errorf("Solved(2, 1) = true before a correct attempt")
+	}
+
+	l.Add(Attempt{Day: 2, Part: 1, Answer: "42", Verdict: client.Correct})
+
+	a, ok := l.Solved(2, 1)
+	if !ok || a.Answer != "42" {
+		t.Errorf("Solved(2, 1) = %+v, %v; expected answer 42", a, ok)
+	}
+}
+
+// save and reload
+func TestSaveLoadRoundTrip(t *testing.T) {
+	dir, err := os.MkdirTemp("", "test_attempts_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "nested", "attempts.json")
+	l, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load of missing file unexpected error: %v", err)
+	}
+
+	when := time.Date(2025, 12, 1, 5, 0, 0, 0, time.UTC)
+	l.Add(Attempt{Day: 6, Part: 2, Answer: "8907730960817", Verdict: client.Correct, Time: when})
+	if err := l.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	reloaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load after save failed: %v", err)
+	}
+
+	got := reloaded.For(6, 2)
+	if len(got) != 1 {
+		t.Fatalf("Expected 1 attempt, got %d", len(got))
+	}
+	if got[0].Answer != "8907730960817" || got[0].Verdict != client.Correct || !got[0].Time.Equal(when) {
+		t.Errorf("reloaded attempt = %+v; expected the saved one", got[0])
+	}
+}
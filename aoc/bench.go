@@ -0,0 +1,150 @@
+/**
+ * Advent of Code 2025 - aoc Command: bench
+ *
+ * Benchmarks every selected day and part on its example fixtures,
+ * puzzle input and a large generated input, prints a table, and
+ * compares it with a stored baseline so performance regressions
+ * stand out.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"aoc/bench"
+	"solver"
+	"solver/example"
+	"solver/gen"
+)
+
+// benchInput is one named input to benchmark a day on
+type benchInput struct {
+	name string
+	data []byte
+}
+
+// benchmarks the selected solvers and reports against the baseline
+func benchCommand(w io.Writer, args []string) error {
+	var sel selection
+	fs := flag.NewFlagSet("bench", flag.ContinueOnError)
+	fs.SetOutput(w)
+	sel.register(fs)
+	baselinePath := fs.String("baseline", "", "baseline file (default <dir>/bench_baseline.json)")
+	save := fs.Bool("save", false, "store this run as the new baseline")
+	threshold := fs.Float64("threshold", 0.2, "relative slowdown that counts as a regression")
+	generated := fs.Bool("generated", true, "also benchmark a generated input of the day's default size")
+	benchtime := fs.Duration("benchtime", time.Second, "minimum run time per benchmark")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	selected, parts, err := sel.resolve()
+	if err != nil {
+		return err
+	}
+
+	if *baselinePath == "" {
+		*baselinePath = filepath.Join(sel.dir, "bench_baseline.json")
+	}
+
+	base, err := bench.LoadBaseline(*baselinePath)
+	if err != nil {
+		return err
+	}
+
+	var results []bench.Result
+	for _, s := range selected {
+		inputs, err := benchInputs(&sel, s, *generated)
+		if err != nil {
+			return err
+		}
+
+		for _, in := range inputs {
+			for _, n := range parts {
+				r, err := bench.Run(s, n, in.name, in.data, *benchtime)
+				if err != nil {
+					return err
+				}
+				results = append(results, r)
+			}
+		}
+	}
+
+	cmps := base.Compare(results, *threshold)
+	if err := bench.WriteTable(w, cmps); err != nil {
+		return err
+	}
+
+	if *save {
+		if err := base.Save(*baselinePath, results); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "saved baseline to %s\n", *baselinePath)
+		return nil
+	}
+
+	regressions := 0
+	for _, c := range cmps {
+		if c.Regressed {
+			regressions++
+		}
+	}
+	if regressions > 0 {
+		return fmt.Errorf("%d benchmark(s) regressed by more than %.0f%%", regressions, *threshold*100)
+	}
+
+	return nil
+}
+
+// returns the inputs to benchmark a day on: --input alone if given,
+// otherwise the example fixtures, the puzzle input when present and
+// optionally a generated input
+func benchInputs(sel *selection, s solver.Solver, generated bool) ([]benchInput, error) {
+	if sel.input != "" {
+		data, err := readInput(sel.input)
+		if err != nil {
+			return nil, err
+		}
+		return []benchInput{{name: "input", data: data}}, nil
+	}
+
+	examples, err := example.Load(example.Dir(sel.dir, s.Day()))
+	if err != nil {
+		return nil, err
+	}
+
+	var inputs []benchInput
+	for _, e := range examples {
+		inputs = append(inputs, benchInput{name: strings.TrimSuffix(e.Name, ".txt"), data: []byte(e.Input)})
+	}
+
+	data, err := readInput(defaultInputPath(sel.dir, s.Day()))
+	switch {
+	case err == nil:
+		inputs = append(inputs, benchInput{name: "input", data: data})
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, err
+	}
+
+	if generated {
+		input, err := gen.Day(s.Day(), 1, 0)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, benchInput{name: "generated", data: []byte(input)})
+	}
+
+	return inputs, nil
+}
@@ -0,0 +1,206 @@
+/**
+ * Advent of Code 2025 - Benchmark Reports
+ *
+ * Times parse-and-solve runs the way `go test -bench` does, collects
+ * ns/op and allocations per day, part and input, and compares them
+ * against a stored JSON baseline to flag regressions.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package bench
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"solver"
+)
+
+// Result is one benchmarked day, part and input
+type Result struct {
+	Day         int     `json:"day"`
+	Part        int     `json:"part"`
+	Input       string  `json:"input"`
+	Size        int     `json:"size"`
+	NsPerOp     float64 `json:"ns_per_op"`
+	BytesPerOp  int64   `json:"bytes_per_op"`
+	AllocsPerOp int64   `json:"allocs_per_op"`
+}
+
+// key identifies a result across runs
+type key struct {
+	Day   int
+	Part  int
+	Input string
+}
+
+func (r Result) key() key {
+	return key{Day: r.Day, Part: r.Part, Input: r.Input}
+}
+
+// runs f repeatedly for at least d, growing the iteration count like
+// testing.B does, and returns the per-op metrics of the final round
+func Measure(d time.Duration, f func() error) (Result, error) {
+	if err := f(); err != nil {
+		return Result{}, err
+	}
+
+	var before, after runtime.MemStats
+	n := 1
+	for {
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		start := time.Now()
+		for i := 0; i < n; i++ {
+			if err := f(); err != nil {
+				return Result{}, err
+			}
+		}
+		elapsed := time.Since(start)
+		runtime.ReadMemStats(&after)
+
+		if elapsed >= d || n >= 1e9 {
+			return Result{
+				NsPerOp:     float64(elapsed.Nanoseconds()) / float64(n),
+				BytesPerOp:  int64(after.TotalAlloc-before.TotalAlloc) / int64(n),
+				AllocsPerOp: int64(after.Mallocs-before.Mallocs) / int64(n),
+			}, nil
+		}
+
+		// aim 20% past the target, growing at least by one and at most 100x
+		next := n * 100
+		if elapsed > 0 {
+			next = int(float64(n) * 1.2 * float64(d) / float64(elapsed))
+		}
+		n = max(n+1, min(next, n*100))
+	}
+}
+
+// benchmarks parsing data and solving part with s
+func Run(s solver.Solver, part int, name string, data []byte, d time.Duration) (Result, error) {
+	r, err := Measure(d, func() error {
+		_, err := solver.Solve(s, bytes.NewReader(data), part)
+		return err
+	})
+	if err != nil {
+		return Result{}, fmt.Errorf("day %d part %d %s: %w", s.Day(), part, name, err)
+	}
+
+	r.Day, r.Part, r.Input, r.Size = s.Day(), part, name, len(data)
+	return r, nil
+}
+
+// Baseline holds stored results to compare new runs against
+type Baseline map[key]Result
+
+// reads a baseline file; a missing file gives an empty baseline
+func LoadBaseline(path string) (Baseline, error) {
+	base := make(Baseline)
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return base, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var results []Result
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	for _, r := range results {
+		base[r.key()] = r
+	}
+
+	return base, nil
+}
+
+// merges results into the baseline and writes it to path in a stable order
+func (base Baseline) Save(path string, results []Result) error {
+	for _, r := range results {
+		base[r.key()] = r
+	}
+
+	all := make([]Result, 0, len(base))
+	for _, r := range base {
+		all = append(all, r)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		a, b := all[i], all[j]
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		if a.Part != b.Part {
+			return a.Part < b.Part
+		}
+		return a.Input < b.Input
+	})
+
+	data, err := json.MarshalIndent(all, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Comparison is a result next to its baseline entry, if any
+type Comparison struct {
+	Result
+	Base      *Result
+	Change    float64 // relative ns/op change, +0.10 being 10% slower
+	Regressed bool
+}
+
+// compares results with the baseline; a result regresses when its
+// ns/op or allocs/op grow by more than threshold (0.2 = 20%)
+func (base Baseline) Compare(results []Result, threshold float64) []Comparison {
+	cmps := make([]Comparison, len(results))
+	for i, r := range results {
+		cmps[i] = Comparison{Result: r}
+
+		b, ok := base[r.key()]
+		if !ok {
+			continue
+		}
+		cmps[i].Base = &b
+
+		if b.NsPerOp > 0 {
+			cmps[i].Change = r.NsPerOp/b.NsPerOp - 1
+		}
+		slower := cmps[i].Change > threshold
+		moreAllocs := float64(r.AllocsPerOp) > float64(b.AllocsPerOp)*(1+threshold)
+		cmps[i].Regressed = slower || moreAllocs
+	}
+	return cmps
+}
+
+// prints comparisons as an aligned table
+func WriteTable(w io.Writer, cmps []Comparison) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "day\tpart\tinput\tsize\tns/op\tB/op\tallocs/op\tbaseline\t")
+
+	for _, c := range cmps {
+		vs := "-"
+		if c.Base != nil {
+			vs = fmt.Sprintf("%+.1f%%", c.Change*100)
+			if c.Regressed {
+				vs += " REGRESSION"
+			}
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%d\t%.0f\t%d\t%d\t%s\t\n",
+			c.Day, c.Part, c.Input, c.Size, c.NsPerOp, c.BytesPerOp, c.AllocsPerOp, vs)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,108 @@
+/**
+ * Test suite for Advent of Code 2025 - Benchmark Reports
+ *
+ * Tests verify measurement, baseline storage and regression flagging.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package bench
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// measure runs until the minimum time and counts allocations
+func TestMeasure(t *testing.T) {
+	calls := 0
+	var sink []byte
+	r, err := Measure(5*time.Millisecond, func() error {
+		calls++
+		sink = make([]byte, 1024)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Measure failed: %v", err)
+	}
+	_ = sink
+
+	if calls < 2 {
+		t.Errorf("Measure called f %d times; expected repeated calls", calls)
+	}
+	if r.NsPerOp <= 0 {
+		t.Errorf("NsPerOp = %f; expected > 0", r.NsPerOp)
+	}
+	if r.AllocsPerOp < 1 || r.BytesPerOp < 1024 {
+		t.Errorf("allocations = %d allocs, %d B; expected at least 1 alloc of 1024 B", r.AllocsPerOp, r.BytesPerOp)
+	}
+}
+
+// measure stops at the first error
+func TestMeasureError(t *testing.T) {
+	want := errors.New("boom")
+	if _, err := Measure(time.Millisecond, func() error { return want }); !errors.Is(err, want) {
+		t.Errorf("Measure error = %v; expected %v", err, want)
+	}
+}
+
+// save, reload and compare
+func TestBaselineCompare(t *testing.T) {
+	dir, err := os.MkdirTemp("", "test_bench_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "bench_baseline.json")
+	base, err := LoadBaseline(path)
+	if err != nil {
+		t.Fatalf("LoadBaseline of missing file unexpected error: %v", err)
+	}
+
+	old := []Result{
+		{Day: 1, Part: 1, Input: "example1", NsPerOp: 1000, AllocsPerOp: 10},
+		{Day: 1, Part: 2, Input: "example1", NsPerOp: 1000, AllocsPerOp: 10},
+		{Day: 1, Part: 2, Input: "input", NsPerOp: 1000, AllocsPerOp: 10},
+	}
+	if err := base.Save(path, old); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	base, err = LoadBaseline(path)
+	if err != nil {
+		t.Fatalf("LoadBaseline failed: %v", err)
+	}
+
+	results := []Result{
+		{Day: 1, Part: 1, Input: "example1", NsPerOp: 1100, AllocsPerOp: 10}, // within threshold
+		{Day: 1, Part: 2, Input: "example1", NsPerOp: 1500, AllocsPerOp: 10}, // slower
+		{Day: 1, Part: 2, Input: "input", NsPerOp: 900, AllocsPerOp: 20},     // more allocations
+		{Day: 2, Part: 1, Input: "input", NsPerOp: 900, AllocsPerOp: 20},     // no baseline
+	}
+	cmps := base.Compare(results, 0.2)
+
+	expected := []bool{false, true, true, false}
+	for i, exp := range expected {
+		if cmps[i].Regressed != exp {
+			t.Errorf("result %d Regressed = %v; expected %v", i, cmps[i].Regressed, exp)
+		}
+	}
+	if cmps[3].Base != nil {
+		t.Errorf("result without baseline has Base %+v; expected nil", cmps[3].Base)
+	}
+
+	var out bytes.Buffer
+	if err := WriteTable(&out, cmps); err != nil {
+		t.Fatalf("WriteTable failed: %v", err)
+	}
+	if got := strings.Count(out.String(), "REGRESSION"); got != 2 {
+		t.Errorf("table flags %d regressions; expected 2:\n%s", got, out.String())
+	}
+}
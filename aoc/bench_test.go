@@ -0,0 +1,79 @@
+/**
+ * Test suite for Advent of Code 2025 - aoc Command: bench
+ *
+ * Tests verify the benchmark table and baseline comparison.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// benchmark a day, save a baseline, then compare against it
+func TestBenchCommand(t *testing.T) {
+	dir := writeExampleInputs(t)
+	defer os.RemoveAll(dir)
+
+	baseline := filepath.Join(dir, "bench_baseline.json")
+	args := []string{"--dir", dir, "--day", "1", "--benchtime", "1ms", "--baseline", baseline}
+
+	var out bytes.Buffer
+	if err := benchCommand(&out, append(args, "--save")); err != nil {
+		t.Fatalf("benchCommand --save failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "saved baseline") {
+		t.Errorf("output missing saved baseline line:\n%s", out.String())
+	}
+	if _, err := os.Stat(baseline); err != nil {
+		t.Fatalf("baseline not written: %v", err)
+	}
+
+	out.Reset()
+	if err := benchCommand(&out, append(args, "--threshold", "1000")); err != nil {
+		t.Fatalf("benchCommand failed: %v", err)
+	}
+
+	// one row per part of the puzzle and generated inputs, each with a
+	// baseline change
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("table has %d lines; expected header and 4 rows:\n%s", len(lines), out.String())
+	}
+	for _, line := range lines[1:] {
+		if !strings.Contains(line, "%") {
+			t.Errorf("row %q; expected a change against the baseline", line)
+		}
+	}
+	if !strings.Contains(out.String(), "generated") {
+		t.Errorf("table has no generated input rows:\n%s", out.String())
+	}
+}
+
+// a slowdown beyond the threshold fails the run
+func TestBenchCommandRegression(t *testing.T) {
+	dir := writeExampleInputs(t)
+	defer os.RemoveAll(dir)
+
+	baseline := filepath.Join(dir, "bench_baseline.json")
+	content := `[{"day": 1, "part": 1, "input": "input", "size": 38, "ns_per_op": 0.001, "bytes_per_op": 0, "allocs_per_op": 0}]`
+	if err := os.WriteFile(baseline, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write baseline: %v", err)
+	}
+
+	var out bytes.Buffer
+	err := benchCommand(&out, []string{"--dir", dir, "--day", "1", "--part", "1", "--generated=false", "--benchtime", "1ms", "--baseline", baseline})
+	if err == nil {
+		t.Fatalf("benchCommand expected regression error but got none:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "REGRESSION") {
+		t.Errorf("output does not flag the regression:\n%s", out.String())
+	}
+}
@@ -0,0 +1,282 @@
+/**
+ * Advent of Code 2025 - Website Client
+ *
+ * Talks to adventofcode.com on behalf of a logged-in user. Inputs
+ * are cached on disk and requests are spaced out so the site is
+ * never hit more often than it asks. Both are kept per site and
+ * session, so a stand-in server never touches the live site's state.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the live puzzle site
+	DefaultBaseURL = "https://adventofcode.com"
+
+	// Year is the event every day in this repository belongs to
+	Year = 2025
+
+	// DefaultUserAgent identifies this tool, as the site asks automated clients to do
+	DefaultUserAgent = "github.com/KleaSCM/AdventOfCode2025 by KleaSCM@gmail.com"
+
+	// DefaultMinInterval is the minimum gap between two requests to the site
+	DefaultMinInterval = 5 * time.Second
+)
+
+var (
+	// ErrNoSession is returned when a request needs a session token and none is set
+	ErrNoSession = errors.New("no session token (set AOC_SESSION or --session)")
+
+	// ErrUnauthorized means the site rejected the session token
+	ErrUnauthorized = errors.New("session token rejected")
+
+	// ErrNotAvailable means the puzzle has not unlocked yet
+	ErrNotAvailable = errors.New("puzzle not available yet")
+)
+
+// RateLimitError is returned when the site answers 429 Too Many Requests
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("rate limited, retry after %s", e.RetryAfter)
+	}
+	return "rate limited"
+}
+
+// StatusError is returned for any other unexpected HTTP status
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, e.Body)
+}
+
+// Client downloads inputs for one user. The zero value is not usable; use New.
+type Client struct {
+	BaseURL     string
+	Session     string
+	UserAgent   string
+	CacheDir    string // root of every site's StateDir; empty disables the input cache and persisted throttling
+	MinInterval time.Duration
+	HTTP        *http.Client
+
+	mu    sync.Mutex
+	last  time.Time // previous request made by this client
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// creates a client for the live site caching under the user cache directory
+func New(session string) *Client {
+	cacheDir := ""
+	if dir, err := os.UserCacheDir(); err == nil {
+		cacheDir = filepath.Join(dir, "aoc", strconv.Itoa(Year))
+	}
+
+	return &Client{
+		BaseURL:     DefaultBaseURL,
+		Session:     session,
+		UserAgent:   DefaultUserAgent,
+		CacheDir:    cacheDir,
+		MinInterval: DefaultMinInterval,
+		HTTP:        &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// returns the directory under CacheDir for BaseURL's host and this
+// session, named after the host and a hash of the session so the token
+// is never written out; empty when CacheDir is
+func (c *Client) StateDir() string {
+	if c.CacheDir == "" {
+		return ""
+	}
+
+	host := c.BaseURL
+	if u, err := url.Parse(c.BaseURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	host = strings.NewReplacer(":", "_", "/", "_", `\`, "_").Replace(host)
+
+	sum := sha256.Sum256([]byte(c.Session))
+	return filepath.Join(c.CacheDir, host, hex.EncodeToString(sum[:6]))
+}
+
+// location of a day's cached input
+func (c *Client) inputCachePath(day int) string {
+	return filepath.Join(c.StateDir(), fmt.Sprintf("day%d.txt", day))
+}
+
+// returns the day's puzzle input, from the cache when possible
+func (c *Client) Input(ctx context.Context, day int) ([]byte, error) {
+	if c.CacheDir != "" {
+		if data, err := os.ReadFile(c.inputCachePath(day)); err == nil {
+			return data, nil
+		}
+	}
+
+	return c.FetchInput(ctx, day)
+}
+
+// downloads the day's puzzle input, bypassing and then refreshing the cache
+func (c *Client) FetchInput(ctx context.Context, day int) ([]byte, error) {
+	url := fmt.Sprintf("%s/%d/day/%d/input", c.BaseURL, Year, day)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch day %d input: %w", day, err)
+	}
+
+	if c.CacheDir != "" {
+		if err := os.MkdirAll(c.StateDir(), 0o700); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(c.inputCachePath(day), body, 0o600); err != nil {
+			return nil, err
+		}
+	}
+
+	return body, nil
+}
+
+// sends an authenticated, throttled request and returns the body of a 200 response
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+
+	if err := c.throttle(req.Context()); err != nil {
+		return nil, err
+	}
+
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	req.Header.Set("User-Agent", c.UserAgent)
+
+	httpClient := c.HTTP
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	case http.StatusBadRequest, http.StatusUnauthorized:
+		return nil, ErrUnauthorized
+	case http.StatusNotFound:
+		return nil, ErrNotAvailable
+	case http.StatusTooManyRequests:
+		seconds, _ := strconv.Atoi(resp.Header.Get("Retry-After"))
+		return nil, &RateLimitError{RetryAfter: time.Duration(seconds) * time.Second}
+	}
+
+	return nil, &StatusError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(body))}
+}
+
+// waits until MinInterval has passed since the previous request, which
+// is remembered in StateDir so separate runs share the limit
+func (c *Client) throttle(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+	sleep := sleepContext
+	if c.sleep != nil {
+		sleep = c.sleep
+	}
+
+	if last, ok := c.lastRequest(); ok {
+		if wait := c.MinInterval - now().Sub(last); wait > 0 {
+			if err := sleep(ctx, wait); err != nil {
+				return err
+			}
+		}
+	}
+
+	c.recordRequest(now())
+	return nil
+}
+
+// time of the previous request by this client or, via the cache, any other run
+func (c *Client) lastRequest() (time.Time, bool) {
+	last := c.last
+	if c.CacheDir != "" {
+		data, err := os.ReadFile(filepath.Join(c.StateDir(), "last-request"))
+		if err == nil {
+			persisted, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(string(data)))
+			if err == nil && persisted.After(last) {
+				last = persisted
+			}
+		}
+	}
+
+	return last, !last.IsZero()
+}
+
+// remembers when a request was made; failures only weaken throttling
+func (c *Client) recordRequest(t time.Time) {
+	c.last = t
+	if c.CacheDir == "" {
+		return
+	}
+
+	if err := os.MkdirAll(c.StateDir(), 0o700); err != nil {
+		return
+	}
+	_ = os.WriteFile(filepath.Join(c.StateDir(), "last-request"), []byte(t.Format(time.RFC3339Nano)), 0o600)
+}
+
+// sleeps for d unless ctx is done first
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,214 @@
+/**
+ * Test suite for Advent of Code 2025 - Website Client
+ *
+ * Tests run the client against the fake site to verify caching,
+ * throttling and how error responses are reported.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"aoc/client/clienttest"
+)
+
+// client pointed at a fake site with its own cache and no throttling
+func newTestClient(t *testing.T, srv *clienttest.Server, session string) (*Client, string) {
+	t.Helper()
+
+	dir, err := os.MkdirTemp("", "test_client_cache_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+
+	c := New(session)
+	c.BaseURL = srv.URL
+	c.CacheDir = dir
+	c.MinInterval = 0
+	c.HTTP = srv.Client()
+	return c, dir
+}
+
+// download then cache
+func TestInputDownloadsAndCaches(t *testing.T) {
+	srv := clienttest.NewServer("secret", map[int]string{1: "L68\nL30\n"})
+	defer srv.Close()
+
+	c, dir := newTestClient(t, srv, "secret")
+	defer os.RemoveAll(dir)
+
+	for i := 0; i < 2; i++ {
+		data, err := c.Input(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("Input failed: %v", err)
+		}
+		if string(data) != "L68\nL30\n" {
+			t.Errorf("Input = %q; expected %q", data, "L68\nL30\n")
+		}
+	}
+
+	if srv.Requests() != 1 {
+		t.Errorf("server saw %d requests; expected 1 (second read should hit the cache)", srv.Requests())
+	}
+
+	cached, err := os.ReadFile(filepath.Join(c.StateDir(), "day1.txt"))
+	if err != nil || string(cached) != "L68\nL30\n" {
+		t.Errorf("cache file = %q, %v; expected the input", cached, err)
+	}
+}
+
+// another site or session never reads the cached input
+func TestCacheKeyedBySiteAndSession(t *testing.T) {
+	srv := clienttest.NewServer("secret", map[int]string{1: "L68\n"})
+	defer srv.Close()
+
+	c, dir := newTestClient(t, srv, "secret")
+	defer os.RemoveAll(dir)
+	if _, err := c.Input(context.Background(), 1); err != nil {
+		t.Fatalf("Input failed: %v", err)
+	}
+
+	live := New("secret")
+	live.CacheDir = dir
+	other := New("other")
+	other.BaseURL = srv.URL
+	other.CacheDir = dir
+
+	for _, o := range []*Client{live, other} {
+		if o.StateDir() == c.StateDir() {
+			t.Errorf("StateDir for %s = %s; expected it to differ from the test site's", o.BaseURL, o.StateDir())
+		}
+		if _, err := os.Stat(o.inputCachePath(1)); err == nil {
+			t.Errorf("input cached for %s at %s; expected none", o.BaseURL, o.inputCachePath(1))
+		}
+		if _, ok := o.lastRequest(); ok {
+			t.Errorf("lastRequest for %s found the test site's request", o.BaseURL)
+		}
+	}
+}
+
+// error responses
+func TestFetchInputErrors(t *testing.T) {
+	srv := clienttest.NewServer("secret", map[int]string{1: "L68\n"})
+	defer srv.Close()
+
+	tests := []struct {
+		session  string
+		day      int
+		expected error
+	}{
+		{"wrong", 1, ErrUnauthorized},
+		{"secret", 12, ErrNotAvailable},
+		{"", 1, ErrNoSession},
+	}
+
+	for _, test := range tests {
+		c, dir := newTestClient(t, srv, test.session)
+		_, err := c.FetchInput(context.Background(), test.day)
+		os.RemoveAll(dir)
+
+		if !errors.Is(err, test.expected) {
+			t.Errorf("FetchInput(session %q, day %d) error = %v; expected %v", test.session, test.day, err, test.expected)
+		}
+	}
+}
+
+// 429 responses
+func TestFetchInputRateLimited(t *testing.T) {
+	srv := clienttest.NewServer("secret", map[int]string{1: "L68\n"})
+	defer srv.Close()
+	srv.SetRateLimited(30)
+
+	c, dir := newTestClient(t, srv, "secret")
+	defer os.RemoveAll(dir)
+
+	_, err := c.FetchInput(context.Background(), 1)
+
+	var rateErr *RateLimitError
+	if !errors.As(err, &rateErr) {
+		t.Fatalf("FetchInput error = %v; expected *RateLimitError", err)
+	}
+	if rateErr.RetryAfter != 30*time.Second {
+		t.Errorf("RetryAfter = %s; expected 30s", rateErr.RetryAfter)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "day1.txt")); err == nil {
+		t.Errorf("rate limited response was cached")
+	}
+}
+
+// spacing between requests, shared through the cache dir
+func TestThrottleAcrossClients(t *testing.T) {
+	srv := clienttest.NewServer("secret", map[int]string{1: "L68\n", 2: "11-22\n"})
+	defer srv.Close()
+
+	first, dir := newTestClient(t, srv, "secret")
+	defer os.RemoveAll(dir)
+
+	clock := time.Date(2025, 12, 1, 5, 0, 0, 0, time.UTC)
+	var slept []time.Duration
+	fake := func(c *Client) {
+		c.MinInterval = 5 * time.Second
+		c.now = func() time.Time { return clock }
+		c.sleep = func(ctx context.Context, d time.Duration) error {
+			slept = append(slept, d)
+			clock = clock.Add(d)
+			return nil
+		}
+	}
+	fake(first)
+
+	if _, err := first.FetchInput(context.Background(), 1); err != nil {
+		t.Fatalf("FetchInput failed: %v", err)
+	}
+	if len(slept) != 0 {
+		t.Errorf("first request slept %v; expected no wait", slept)
+	}
+
+	// a separate run sharing the cache dir, two seconds later
+	clock = clock.Add(2 * time.Second)
+	second := New("secret")
+	second.BaseURL = srv.URL
+	second.CacheDir = dir
+	second.HTTP = srv.Client()
+	fake(second)
+
+	if _, err := second.FetchInput(context.Background(), 2); err != nil {
+		t.Fatalf("FetchInput failed: %v", err)
+	}
+	if len(slept) != 1 || slept[0] != 3*time.Second {
+		t.Errorf("second request slept %v; expected [3s]", slept)
+	}
+}
+
+// cancelled while waiting
+func TestThrottleHonoursContext(t *testing.T) {
+	srv := clienttest.NewServer("secret", map[int]string{1: "L68\n"})
+	defer srv.Close()
+
+	c, dir := newTestClient(t, srv, "secret")
+	defer os.RemoveAll(dir)
+	c.MinInterval = time.Hour
+
+	if _, err := c.FetchInput(context.Background(), 1); err != nil {
+		t.Fatalf("FetchInput failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.FetchInput(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("FetchInput with cancelled context error = %v; expected context.Canceled", err)
+	}
+	if srv.Requests() != 1 {
+		t.Errorf("server saw %d requests; expected 1", srv.Requests())
+	}
+}
@@ -0,0 +1,194 @@
+/**
+ * Advent of Code 2025 - Fake Website for Tests
+ *
+ * A stand-in for adventofcode.com built on httptest, so the client
+ * and the commands using it can be developed and tested offline.
+ * It serves inputs and judges submitted answers the way the site does.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package clienttest
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+)
+
+// part identifies one half of a day's puzzle
+type part struct {
+	day, level int
+}
+
+// Server serves puzzle inputs to a single user identified by Session
+type Server struct {
+	*httptest.Server
+	Session string
+
+	mu          sync.Mutex
+	inputs      map[int]string
+	answers     map[part]string
+	solved      map[part]bool
+	submissions []string
+	requests    int
+	retryAfter  int
+	cooldown    int
+}
+
+// starts a fake site serving inputs (keyed by day) for session
+func NewServer(session string, inputs map[int]string) *Server {
+	s := &Server{
+		Session: session,
+		inputs:  inputs,
+		answers: make(map[part]string),
+		solved:  make(map[part]bool),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{year}/day/{day}/input", s.handleInput)
+	mux.HandleFunc("POST /{year}/day/{day}/answer", s.handleAnswer)
+	s.Server = httptest.NewServer(s.count(mux))
+
+	return s
+}
+
+// number of requests the server has received
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// makes every following request fail with 429 and the given Retry-After;
+// 0 turns rate limiting off again
+func (s *Server) SetRateLimited(retryAfterSeconds int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.retryAfter = retryAfterSeconds
+}
+
+// sets the answer the site accepts for one part of a day
+func (s *Server) SetAnswer(day, level int, answer string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.answers[part{day, level}] = answer
+}
+
+// makes every following submission fail as too recent, with the given
+// number of seconds left to wait; 0 ends the cooldown
+func (s *Server) SetCooldown(seconds int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cooldown = seconds
+}
+
+// answers received so far, in order
+func (s *Server) Submissions() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.submissions...)
+}
+
+// counts requests and applies rate limiting and session checks
+func (s *Server) count(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests++
+		retryAfter := s.retryAfter
+		s.mu.Unlock()
+
+		if retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+			return
+		}
+
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != s.Session {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleInput(w http.ResponseWriter, r *http.Request) {
+	day, err := strconv.Atoi(r.PathValue("day"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	input, ok := s.inputs[day]
+	s.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "Please don't repeatedly request this endpoint before it unlocks!", http.StatusNotFound)
+		return
+	}
+
+	fmt.Fprint(w, input)
+}
+
+// judges a submitted answer and replies with the site's wording
+func (s *Server) handleAnswer(w http.ResponseWriter, r *http.Request) {
+	day, err := strconv.Atoi(r.PathValue("day"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	level, _ := strconv.Atoi(r.PostFormValue("level"))
+	answer := r.PostFormValue("answer")
+	key := part{day, level}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.submissions = append(s.submissions, answer)
+
+	var message string
+	switch expected, ok := s.answers[key]; {
+	case !ok:
+		http.Error(w, "Please don't repeatedly request this endpoint before it unlocks!", http.StatusNotFound)
+		return
+	case s.cooldown > 0:
+		message = fmt.Sprintf("You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have %s left to wait.", formatWait(s.cooldown))
+	case s.solved[key]:
+		message = "You don't seem to be solving the right level.  Did you already complete it?"
+	case answer == expected:
+		s.solved[key] = true
+		message = "That's the right answer!  You are one gold star closer to decorating the North Pole."
+	default:
+		message = "That's not the right answer" + hint(answer, expected) + ".  Please wait one minute before trying again."
+	}
+
+	fmt.Fprintf(w, "<html><body><main>\n<article><p>%s <a href=\"/2025/day/%d\">[Return to Day %d]</a></p></article>\n</main></body></html>\n", message, day, day)
+}
+
+// "; your answer is too high" style hint when both answers are numbers
+func hint(answer, expected string) string {
+	a, okA := new(big.Int).SetString(answer, 10)
+	e, okE := new(big.Int).SetString(expected, 10)
+	if !okA || !okE {
+		return ""
+	}
+	if a.Cmp(e) > 0 {
+		return "; your answer is too high"
+	}
+	return "; your answer is too low"
+}
+
+// renders seconds the way the site does, e.g. "1m 5s" or "42s"
+func formatWait(seconds int) string {
+	if seconds >= 60 {
+		return fmt.Sprintf("%dm %ds", seconds/60, seconds%60)
+	}
+	return fmt.Sprintf("%ds", seconds)
+}
@@ -0,0 +1,155 @@
+/**
+ * Advent of Code 2025 - Answer Submission
+ *
+ * Posts answers to the site and turns the prose reply ("too high",
+ * "wait 1m 5s", ...) into a typed result.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict is the site's judgement of a submitted answer
+type Verdict int
+
+const (
+	Unknown          Verdict = iota // reply not recognised
+	Correct                         // answer accepted
+	TooHigh                         // wrong, and above the right answer
+	TooLow                          // wrong, and below the right answer
+	Incorrect                       // wrong, with no hint
+	TooSoon                         // not judged, submitted during a cooldown
+	AlreadyCompleted                // not judged, the part is already solved
+)
+
+var verdictNames = map[Verdict]string{
+	Unknown:          "unknown",
+	Correct:          "correct",
+	TooHigh:          "too high",
+	TooLow:           "too low",
+	Incorrect:        "incorrect",
+	TooSoon:          "too soon",
+	AlreadyCompleted: "already completed",
+}
+
+func (v Verdict) String() string {
+	if name, ok := verdictNames[v]; ok {
+		return name
+	}
+	return "Verdict(" + strconv.Itoa(int(v)) + ")"
+}
+
+// stores verdicts by name so log files stay readable
+func (v Verdict) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+func (v *Verdict) UnmarshalText(text []byte) error {
+	for verdict, name := range verdictNames {
+		if name == string(text) {
+			*v = verdict
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown verdict %q", text)
+}
+
+// Judged reports whether the site actually checked the answer
+func (v Verdict) Judged() bool {
+	return v == Correct || v == TooHigh || v == TooLow || v == Incorrect
+}
+
+// SubmitResult is the parsed reply to a submission
+type SubmitResult struct {
+	Verdict Verdict
+	Wait    time.Duration // how long before another answer may be submitted
+	Message string        // the site's reply as plain text
+}
+
+var (
+	articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagPattern     = regexp.MustCompile(`<[^>]*>`)
+	leftPattern    = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	waitPattern    = regexp.MustCompile(`wait (\w+) minutes? before trying again`)
+)
+
+var numberWords = map[string]int{
+	"one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10,
+}
+
+// turns the HTML page returned by a submission into a result
+func ParseSubmitResponse(body string) SubmitResult {
+	text := body
+	if m := articlePattern.FindStringSubmatch(body); m != nil {
+		text = m[1]
+	}
+	text = html.UnescapeString(tagPattern.ReplaceAllString(text, ""))
+	text = strings.Join(strings.Fields(text), " ")
+
+	result := SubmitResult{Message: text}
+
+	switch {
+	case strings.Contains(text, "That's the right answer"):
+		result.Verdict = Correct
+	case strings.Contains(text, "answer too recently"):
+		result.Verdict = TooSoon
+	case strings.Contains(text, "solving the right level"):
+		result.Verdict = AlreadyCompleted
+	case strings.Contains(text, "your answer is too high"):
+		result.Verdict = TooHigh
+	case strings.Contains(text, "your answer is too low"):
+		result.Verdict = TooLow
+	case strings.Contains(text, "That's not the right answer"):
+		result.Verdict = Incorrect
+	}
+
+	if m := leftPattern.FindStringSubmatch(text); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		result.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if m := waitPattern.FindStringSubmatch(text); m != nil {
+		minutes, ok := numberWords[m[1]]
+		if !ok {
+			minutes, _ = strconv.Atoi(m[1])
+		}
+		result.Wait = time.Duration(minutes) * time.Minute
+	}
+
+	return result
+}
+
+// posts answer for one part of a day and parses the reply
+func (c *Client) Submit(ctx context.Context, day, part int, answer string) (SubmitResult, error) {
+	endpoint := fmt.Sprintf("%s/%d/day/%d/answer", c.BaseURL, Year, day)
+	form := url.Values{
+		"level":  {strconv.Itoa(part)},
+		"answer": {answer},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return SubmitResult{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	body, err := c.do(req)
+	if err != nil {
+		return SubmitResult{}, fmt.Errorf("submit day %d part %d: %w", day, part, err)
+	}
+
+	return ParseSubmitResponse(string(body)), nil
+}
@@ -0,0 +1,118 @@
+/**
+ * Test suite for Advent of Code 2025 - Answer Submission
+ *
+ * Tests verify reply parsing and submissions against the fake site.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package client
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"aoc/client/clienttest"
+)
+
+// reply parsing
+func TestParseSubmitResponse(t *testing.T) {
+	wrap := func(p string) string {
+		return "<html><main>\n<article><p>" + p + "</p></article>\n</main></html>"
+	}
+
+	tests := []struct {
+		body    string
+		verdict Verdict
+		wait    time.Duration
+	}{
+		{wrap(`That's the right answer!  You are one gold star closer to decorating. <a href="/2025">[Return]</a>`), Correct, 0},
+		{wrap(`That's not the right answer; your answer is too high.  Please wait one minute before trying again.`), TooHigh, time.Minute},
+		{wrap(`That's not the right answer; your answer is too low.  Please wait 5 minutes before trying again.`), TooLow, 5 * time.Minute},
+		{wrap(`That's not the right answer.  If you're stuck, make sure you're using the full input data.`), Incorrect, 0},
+		{wrap(`You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 23s left to wait.`), TooSoon, 83 * time.Second},
+		{wrap(`You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 34s left to wait.`), TooSoon, 34 * time.Second},
+		{wrap(`You don't seem to be solving the right level.  Did you already complete it?`), AlreadyCompleted, 0},
+		{"<html>something else</html>", Unknown, 0},
+	}
+
+	for _, test := range tests {
+		result := ParseSubmitResponse(test.body)
+		if result.Verdict != test.verdict || result.Wait != test.wait {
+			t.Errorf("ParseSubmitResponse(%q) = %v, %s; expected %v, %s", result.Message, result.Verdict, result.Wait, test.verdict, test.wait)
+		}
+	}
+}
+
+// message text
+func TestParseSubmitResponseMessage(t *testing.T) {
+	body := `<article><p>That&#39;s the right answer!  <a href="/2025/day/1">[Return to Day 1]</a></p></article>`
+
+	expected := "That's the right answer! [Return to Day 1]"
+	if result := ParseSubmitResponse(body); result.Message != expected {
+		t.Errorf("Message = %q; expected %q", result.Message, expected)
+	}
+}
+
+// verdict names
+func TestVerdictText(t *testing.T) {
+	for v := Unknown; v <= AlreadyCompleted; v++ {
+		text, err := v.MarshalText()
+		if err != nil {
+			t.Fatalf("%v.MarshalText() unexpected error: %v", v, err)
+		}
+
+		var back Verdict
+		if err := back.UnmarshalText(text); err != nil || back != v {
+			t.Errorf("UnmarshalText(%q) = %v, %v; expected %v", text, back, err, v)
+		}
+	}
+
+	var v Verdict
+	if err := v.UnmarshalText([]byte("bogus")); err == nil {
+		t.Errorf("UnmarshalText(bogus) expected error but got none")
+	}
+}
+
+// submit against the fake site
+func TestSubmit(t *testing.T) {
+	srv := clienttest.NewServer("secret", nil)
+	defer srv.Close()
+	srv.SetAnswer(1, 1, "1150")
+
+	c, dir := newTestClient(t, srv, "secret")
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+
+	tests := []struct {
+		answer  string
+		verdict Verdict
+	}{
+		{"2000", TooHigh},
+		{"3", TooLow},
+		{"1150", Correct},
+		{"1150", AlreadyCompleted},
+	}
+
+	for _, test := range tests {
+		result, err := c.Submit(ctx, 1, 1, test.answer)
+		if err != nil {
+			t.Fatalf("Submit(%q) failed: %v", test.answer, err)
+		}
+		if result.Verdict != test.verdict {
+			t.Errorf("Submit(%q) = %v (%q); expected %v", test.answer, result.Verdict, result.Message, test.verdict)
+		}
+	}
+
+	srv.SetCooldown(65)
+	result, err := c.Submit(ctx, 1, 1, "1")
+	if err != nil {
+		t.Fatalf("Submit during cooldown failed: %v", err)
+	}
+	if result.Verdict != TooSoon || result.Wait != 65*time.Second {
+		t.Errorf("Submit during cooldown = %v, %s; expected too soon, 1m5s", result.Verdict, result.Wait)
+	}
+}
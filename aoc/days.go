@@ -0,0 +1,120 @@
+/**
+ * Advent of Code 2025 - aoc Command: Day Selection
+ *
+ * Importing each day's library registers its solver, so adding a
+ * day to the command is a single import line here. The helpers
+ * below turn the shared --day/--part/--input flags into work.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	_ "day1/dial"
+	_ "day2/productid"
+	_ "day3/battery"
+	_ "day4/rolls"
+	_ "day5/ranges"
+	_ "day6/worksheet"
+
+	"solver"
+)
+
+// selection holds the flags every solving command shares
+type selection struct {
+	day   int
+	part  int
+	input string
+	dir   string
+}
+
+// registers the shared flags on fs
+func (sel *selection) register(fs *flag.FlagSet) {
+	fs.IntVar(&sel.day, "day", 0, "day to solve (0 for every day)")
+	fs.IntVar(&sel.part, "part", 0, "part to solve (0 for both)")
+	fs.StringVar(&sel.input, "input", "", "input file, - for stdin (single day only, default <dir>/dayN/input/input.txt)")
+	fs.StringVar(&sel.dir, "dir", "..", "repository root containing the dayN folders")
+}
+
+// returns the solvers and parts the flags select
+func (sel *selection) resolve() ([]solver.Solver, []int, error) {
+	selected, err := selectSolvers(sel.day)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parts, err := selectParts(sel.part)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if sel.input != "" && len(selected) != 1 {
+		return nil, nil, fmt.Errorf("--input requires --day")
+	}
+
+	return selected, parts, nil
+}
+
+// input file for day, honouring --input
+func (sel *selection) inputPath(day int) string {
+	if sel.input != "" {
+		return sel.input
+	}
+	return defaultInputPath(sel.dir, day)
+}
+
+// returns the solver for a single day, or every day when day is 0
+func selectSolvers(day int) ([]solver.Solver, error) {
+	if day == 0 {
+		return solver.All(), nil
+	}
+
+	s, ok := solver.Lookup(day)
+	if !ok {
+		return nil, fmt.Errorf("no solver registered for day %d", day)
+	}
+
+	return []solver.Solver{s}, nil
+}
+
+// returns the parts to solve, or both when part is 0
+func selectParts(part int) ([]int, error) {
+	switch part {
+	case 0:
+		return []int{1, 2}, nil
+	case 1, 2:
+		return []int{part}, nil
+	}
+	return nil, fmt.Errorf("invalid part %d (expected 1 or 2)", part)
+}
+
+// default location of a day's puzzle input below the repository root
+func defaultInputPath(dir string, day int) string {
+	return filepath.Join(dir, fmt.Sprintf("day%d", day), "input", "input.txt")
+}
+
+// reads a whole puzzle input, "-" meaning stdin
+func readInput(filename string) ([]byte, error) {
+	if filename == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(filename)
+}
+
+// parses raw input with the day's solver
+func parseInput(s solver.Solver, data []byte) (solver.Puzzle, error) {
+	p, err := s.Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("day %d: %w", s.Day(), err)
+	}
+	return p, nil
+}
@@ -0,0 +1,111 @@
+/**
+ * Test suite for Advent of Code 2025 - aoc Command: Day Selection
+ *
+ * Tests verify day/part selection and provide the example inputs
+ * shared by the command tests.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// worked examples from each day's problem statement
+var exampleInputs = map[int]string{
+	1: "L68\nL30\nR48\nL5\nR60\nL55\nL1\nL99\nR14\nL82\n",
+	2: "11-22,95-115,998-1012\n",
+	3: "987654321111111\n811111111111119\n234234234234278\n818181911112111\n",
+	4: "..@@.@@@@.\n@@@.@.@.@@\n@@@@@.@.@@\n@.@@@@..@.\n@@.@@@@.@@\n.@@@@@@@.@\n.@.@.@.@@@\n@.@@@.@@@@\n.@@@@@@@@.\n@.@.@@@.@.\n",
+	5: "3-5\n10-14\n16-20\n12-18\n\n1\n5\n8\n11\n17\n32\n",
+	6: "123 328  51 64 \n 45 64  387 23 \n  6 98  215 314\n*   +   *   +  \n",
+}
+
+// lays the example inputs out like a checkout and returns its root
+func writeExampleInputs(t *testing.T) string {
+	t.Helper()
+
+	dir, err := os.MkdirTemp("", "test_aoc_inputs_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+
+	for day, content := range exampleInputs {
+		filename := defaultInputPath(dir, day)
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			t.Fatalf("Failed to create input dir: %v", err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+			t.Fatalf("Failed to write input: %v", err)
+		}
+	}
+
+	return dir
+}
+
+// select solvers
+func TestSelectSolvers(t *testing.T) {
+	all, err := selectSolvers(0)
+	if err != nil {
+		t.Fatalf("selectSolvers(0) unexpected error: %v", err)
+	}
+	if len(all) != 6 {
+		t.Errorf("selectSolvers(0) returned %d solvers; expected 6", len(all))
+	}
+
+	single, err := selectSolvers(3)
+	if err != nil {
+		t.Fatalf("selectSolvers(3) unexpected error: %v", err)
+	}
+	if len(single) != 1 || single[0].Day() != 3 {
+		t.Errorf("selectSolvers(3) = %+v; expected day 3 only", single)
+	}
+
+	if _, err := selectSolvers(26); err == nil {
+		t.Errorf("selectSolvers(26) expected error but got none")
+	}
+}
+
+// select parts
+func TestSelectParts(t *testing.T) {
+	tests := []struct {
+		part     int
+		expected []int
+		hasError bool
+	}{
+		{0, []int{1, 2}, false},
+		{1, []int{1}, false},
+		{2, []int{2}, false},
+		{3, nil, true},
+		{-1, nil, true},
+	}
+
+	for _, test := range tests {
+		result, err := selectParts(test.part)
+
+		if test.hasError {
+			if err == nil {
+				t.Errorf("selectParts(%d) expected error but got none", test.part)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("selectParts(%d) unexpected error: %v", test.part, err)
+		}
+		if len(result) != len(test.expected) {
+			t.Errorf("selectParts(%d) = %v; expected %v", test.part, result, test.expected)
+			continue
+		}
+		for i := range result {
+			if result[i] != test.expected[i] {
+				t.Errorf("selectParts(%d) = %v; expected %v", test.part, result, test.expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+/**
+ * Advent of Code 2025 - aoc Command: examples
+ *
+ * Extracts the worked examples and stated answers from each day's
+ * problem text into dayN/examples fixtures, which the tests then
+ * run every registered solver against.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"aoc/extract"
+	"solver/example"
+)
+
+// rewrites the example fixtures of the selected days
+func examplesCommand(w io.Writer, args []string) error {
+	fs := flag.NewFlagSet("examples", flag.ContinueOnError)
+	fs.SetOutput(w)
+	day := fs.Int("day", 0, "day to extract (0 for every registered day)")
+	dir := fs.String("dir", "..", "repository root containing the dayN folders")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	selected, err := selectSolvers(*day)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range selected {
+		problemDir := filepath.Join(*dir, fmt.Sprintf("day%d", s.Day()), "problem")
+		sections, err := extract.Sections(problemDir)
+		if err != nil {
+			return err
+		}
+
+		examples := extract.Examples(sections)
+		if len(examples) == 0 {
+			fmt.Fprintf(w, "Day %d: no examples found in %s\n", s.Day(), problemDir)
+			continue
+		}
+
+		fixtures := example.Dir(*dir, s.Day())
+		if err := example.Save(fixtures, examples); err != nil {
+			return err
+		}
+
+		for _, e := range examples {
+			var stated []string
+			for _, part := range e.Parts() {
+				stated = append(stated, fmt.Sprintf("part %d = %s", part, e.Answers[part]))
+			}
+			fmt.Fprintf(w, "Day %d: wrote %s (%s)\n", s.Day(), filepath.Join(fixtures, e.Name), strings.Join(stated, ", "))
+		}
+	}
+
+	return nil
+}
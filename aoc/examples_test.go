@@ -0,0 +1,66 @@
+/**
+ * Test suite for Advent of Code 2025 - aoc Command: examples
+ *
+ * Tests run every registered solver against the extracted example
+ * fixtures and check extraction writes them.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"solver/example"
+	"solver/solvertest"
+)
+
+// every solver against the checked-in fixtures
+func TestExampleFixtures(t *testing.T) {
+	solvertest.RunAll(t, "..")
+}
+
+// extraction from a day's problem text into a fresh checkout
+func TestExamplesCommand(t *testing.T) {
+	dir, err := os.MkdirTemp("", "test_aoc_examples_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	problem, err := os.ReadFile(filepath.Join("..", "day1", "problem", "problem.txt"))
+	if err != nil {
+		t.Fatalf("Failed to read day 1 problem: %v", err)
+	}
+	problemDir := filepath.Join(dir, "day1", "problem")
+	if err := os.MkdirAll(problemDir, 0o755); err != nil {
+		t.Fatalf("Failed to create problem dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(problemDir, "problem.txt"), problem, 0o644); err != nil {
+		t.Fatalf("Failed to write problem: %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := examplesCommand(&out, []string{"--day", "1", "--dir", dir}); err != nil {
+		t.Fatalf("examplesCommand failed: %v", err)
+	}
+
+	examples, err := example.Load(example.Dir(dir, 1))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(examples) != 1 {
+		t.Fatalf("wrote %d examples; expected 1", len(examples))
+	}
+	if examples[0].Input != exampleInputs[1] {
+		t.Errorf("Input = %q; expected %q", examples[0].Input, exampleInputs[1])
+	}
+	if examples[0].Answers[1] != "3" || examples[0].Answers[2] != "6" {
+		t.Errorf("Answers = %v; expected part 1 = 3, part 2 = 6", examples[0].Answers)
+	}
+}
@@ -0,0 +1,164 @@
+/**
+ * Advent of Code 2025 - Example Extraction
+ *
+ * Pulls the worked examples and their stated answers out of the
+ * plain-text problem statements. The text has lost its markup, so
+ * this leans on how the puzzles are written: an example follows a
+ * line like "For example:" and runs until the prose resumes, and
+ * the answer is the last number in the last sentence that talks
+ * about the example or its total.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package extract
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"solver/example"
+)
+
+var (
+	wordPattern   = regexp.MustCompile(`[A-Za-z]{2,}`)
+	numberPattern = regexp.MustCompile(`\b\d+\b`)
+	answerPattern = regexp.MustCompile(`(?i)\b(example|total)\b`)
+)
+
+// reads a day's problem folder and returns the statement of each part;
+// part 2 is either problem/part2.txt or follows a "part 2" line in
+// problem/problem.txt
+func Sections(dir string) ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "problem.txt"))
+	if err != nil {
+		return nil, err
+	}
+
+	var sections []string
+	var current []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.EqualFold(strings.TrimSpace(line), "part 2") {
+			sections = append(sections, strings.Join(current, "\n"))
+			current = nil
+			continue
+		}
+		current = append(current, line)
+	}
+	sections = append(sections, strings.Join(current, "\n"))
+
+	part2, err := os.ReadFile(filepath.Join(dir, "part2.txt"))
+	if err == nil {
+		sections = append(sections, string(part2))
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	return sections, nil
+}
+
+// extracts the examples from each part's statement, sections[0] being
+// part 1. A part that reuses an earlier example, or only describes it
+// in prose, adds its answer to that example instead of a new one
+func Examples(sections []string) []example.Example {
+	var examples []example.Example
+
+	for i, text := range sections {
+		part := i + 1
+		lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+		answer, ok := findAnswer(lines)
+		if !ok {
+			continue
+		}
+
+		input := findBlock(lines)
+		idx := -1
+		for j, e := range examples {
+			if e.Input == input || input == "" {
+				idx = j
+			}
+		}
+
+		if idx < 0 {
+			if input == "" {
+				continue
+			}
+			examples = append(examples, example.Example{
+				Name:    fmt.Sprintf("example%d.txt", len(examples)+1),
+				Input:   input,
+				Answers: make(map[int]string),
+			})
+			idx = len(examples) - 1
+		}
+		examples[idx].Answers[part] = answer
+	}
+
+	return examples
+}
+
+// introduces an example, e.g. "For example:" or
+// "Here's the example worksheet again:"
+func isIntro(line string) bool {
+	line = strings.TrimSpace(line)
+	return strings.HasSuffix(line, ":") && strings.Contains(strings.ToLower(line), "example")
+}
+
+// prose has words, puzzle input at most a stray letter or two
+func isProse(line string) bool {
+	return len(wordPattern.FindAllString(line, 2)) == 2
+}
+
+// returns the first non-empty example block, or "" if there is none
+func findBlock(lines []string) string {
+	for i, line := range lines {
+		if !isIntro(line) {
+			continue
+		}
+
+		j := i + 1
+		for j < len(lines) && strings.TrimSpace(lines[j]) == "" {
+			j++
+		}
+
+		var block []string
+		for ; j < len(lines) && !isProse(lines[j]); j++ {
+			block = append(block, lines[j])
+		}
+		for len(block) > 0 && strings.TrimSpace(block[len(block)-1]) == "" {
+			block = block[:len(block)-1]
+		}
+		if len(block) == 0 {
+			continue
+		}
+
+		// long inputs are sometimes shown wrapped, with a note saying
+		// the real input is a single line
+		if j < len(lines) && strings.Contains(lines[j], "wrapped") {
+			return strings.Join(block, "") + "\n"
+		}
+		return strings.Join(block, "\n") + "\n"
+	}
+
+	return ""
+}
+
+// returns the last number of the last line that mentions the example
+// or a total
+func findAnswer(lines []string) (string, bool) {
+	for i := len(lines) - 1; i >= 0; i-- {
+		if !answerPattern.MatchString(lines[i]) {
+			continue
+		}
+		numbers := numberPattern.FindAllString(lines[i], -1)
+		if len(numbers) > 0 {
+			return numbers[len(numbers)-1], true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,128 @@
+/**
+ * Test suite for Advent of Code 2025 - Example Extraction
+ *
+ * Tests verify examples and answers are found in problem text shaped
+ * like the real statements.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package extract
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const part1Text = `Count the things. For example:
+
+3-5
+10-14
+
+In this example, the ranges overlap:
+
+3-5 and 10-14 do not overlap.
+So, in this example, 2 ranges are listed.
+
+How many ranges are there?
+`
+
+// example block and stated answer
+func TestExamplesSinglePart(t *testing.T) {
+	examples := Examples([]string{part1Text})
+	if len(examples) != 1 {
+		t.Fatalf("Examples returned %d examples; expected 1", len(examples))
+	}
+
+	e := examples[0]
+	if e.Input != "3-5\n10-14\n" {
+		t.Errorf("Input = %q; expected %q", e.Input, "3-5\n10-14\n")
+	}
+	if e.Answers[1] != "2" {
+		t.Errorf("part 1 answer = %q; expected 2", e.Answers[1])
+	}
+}
+
+// part 2 describing the same example in prose reuses it
+func TestExamplesPart2Prose(t *testing.T) {
+	part2 := "Following the same ranges as in the above example, count differently:\n\n" +
+		"The first range has 3 IDs.\n" +
+		"In this example, the new total would be 8.\n"
+
+	examples := Examples([]string{part1Text, part2})
+	if len(examples) != 1 {
+		t.Fatalf("Examples returned %d examples; expected 1", len(examples))
+	}
+	if examples[0].Answers[2] != "8" {
+		t.Errorf("part 2 answer = %q; expected 8", examples[0].Answers[2])
+	}
+}
+
+// part 2 showing a different input gets its own example
+func TestExamplesPart2NewInput(t *testing.T) {
+	part2 := "Here are the ranges from the above example:\n\n3-5\n\nThat gives a total of 3 IDs.\n"
+
+	examples := Examples([]string{part1Text, part2})
+	if len(examples) != 2 {
+		t.Fatalf("Examples returned %d examples; expected 2", len(examples))
+	}
+	if examples[1].Input != "3-5\n" || examples[1].Answers[2] != "3" {
+		t.Errorf("second example = %+v; expected input 3-5 with part 2 = 3", examples[1])
+	}
+	if _, ok := examples[0].Answers[2]; ok {
+		t.Errorf("first example unexpectedly has a part 2 answer")
+	}
+}
+
+// wrapped input is joined back into one line
+func TestExamplesWrapped(t *testing.T) {
+	text := "For example:\n\n11-22,95-115,\n998-1012\n" +
+		"(The ranges are wrapped here for legibility.)\n\n" +
+		"Adding them up in this example produces 1142.\n"
+
+	examples := Examples([]string{text})
+	if len(examples) != 1 {
+		t.Fatalf("Examples returned %d examples; expected 1", len(examples))
+	}
+	if examples[0].Input != "11-22,95-115,998-1012\n" {
+		t.Errorf("Input = %q; expected the wrapped lines joined", examples[0].Input)
+	}
+}
+
+// part 2 inline in problem.txt and in part2.txt
+func TestSections(t *testing.T) {
+	dir, err := os.MkdirTemp("", "test_extract_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.WriteFile(filepath.Join(dir, "problem.txt"), []byte("one\n\npart 2\n\ntwo\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write problem.txt: %v", err)
+	}
+
+	sections, err := Sections(dir)
+	if err != nil {
+		t.Fatalf("Sections failed: %v", err)
+	}
+	if len(sections) != 2 || sections[0] != "one\n" || sections[1] != "\ntwo\n" {
+		t.Errorf("Sections = %q; expected part 1 and part 2 split at the part 2 line", sections)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "problem.txt"), []byte("one\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write problem.txt: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "part2.txt"), []byte("two\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write part2.txt: %v", err)
+	}
+
+	sections, err = Sections(dir)
+	if err != nil {
+		t.Fatalf("Sections failed: %v", err)
+	}
+	if len(sections) != 2 || sections[1] != "two\n" {
+		t.Errorf("Sections = %q; expected part2.txt as part 2", sections)
+	}
+}
@@ -0,0 +1,102 @@
+/**
+ * Advent of Code 2025 - aoc Command: fetch
+ *
+ * Downloads puzzle inputs with the user's session token and puts
+ * them where `aoc run` and the day commands expect to find them.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"aoc/client"
+)
+
+// builds a client from the shared website flags
+type clientFlags struct {
+	session     string
+	baseURL     string
+	cache       string
+	minInterval time.Duration
+}
+
+// registers the website flags on fs
+func (cf *clientFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&cf.session, "session", "", "session cookie (default $AOC_SESSION)")
+	fs.StringVar(&cf.baseURL, "base-url", client.DefaultBaseURL, "puzzle site, e.g. a local stand-in server")
+	fs.StringVar(&cf.cache, "cache", "", "cache directory (default per-user cache dir)")
+	fs.DurationVar(&cf.minInterval, "min-interval", client.DefaultMinInterval, "minimum gap between requests to the site")
+}
+
+// returns a client configured from the flags and environment
+func (cf *clientFlags) client() *client.Client {
+	session := cf.session
+	if session == "" {
+		session = os.Getenv("AOC_SESSION")
+	}
+
+	c := client.New(session)
+	c.BaseURL = cf.baseURL
+	c.MinInterval = cf.minInterval
+	if cf.cache != "" {
+		c.CacheDir = cf.cache
+	}
+	return c
+}
+
+// downloads inputs for the selected days into their day folders
+func fetchCommand(w io.Writer, args []string) error {
+	var cf clientFlags
+	fs := flag.NewFlagSet("fetch", flag.ContinueOnError)
+	fs.SetOutput(w)
+	cf.register(fs)
+	day := fs.Int("day", 0, "day to fetch (0 for every registered day)")
+	dir := fs.String("dir", "..", "repository root containing the dayN folders")
+	force := fs.Bool("force", false, "download again even if the input is cached")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	selected, err := selectSolvers(*day)
+	if err != nil {
+		return err
+	}
+
+	c := cf.client()
+	ctx := context.Background()
+
+	for _, s := range selected {
+		var data []byte
+		if *force {
+			data, err = c.FetchInput(ctx, s.Day())
+		} else {
+			data, err = c.Input(ctx, s.Day())
+		}
+		if err != nil {
+			return err
+		}
+
+		filename := defaultInputPath(*dir, s.Day())
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filename, data, 0o644); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "Day %d: wrote %d bytes to %s\n", s.Day(), len(data), filename)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+/**
+ * Test suite for Advent of Code 2025 - aoc Command: fetch
+ *
+ * Tests fetch inputs from the fake site into a temporary checkout.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"aoc/client/clienttest"
+)
+
+// fetch one day
+func TestFetchCommand(t *testing.T) {
+	srv := clienttest.NewServer("secret", map[int]string{3: exampleInputs[3]})
+	defer srv.Close()
+
+	dir, err := os.MkdirTemp("", "test_fetch_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	args := []string{
+		"--day", "3",
+		"--dir", dir,
+		"--session", "secret",
+		"--base-url", srv.URL,
+		"--cache", filepath.Join(dir, "cache"),
+	}
+
+	for i := 0; i < 2; i++ {
+		var out bytes.Buffer
+		if err := fetchCommand(&out, args); err != nil {
+			t.Fatalf("fetchCommand failed: %v", err)
+		}
+	}
+
+	if srv.Requests() != 1 {
+		t.Errorf("server saw %d requests; expected 1", srv.Requests())
+	}
+
+	data, err := os.ReadFile(defaultInputPath(dir, 3))
+	if err != nil {
+		t.Fatalf("input not written: %v", err)
+	}
+	if string(data) != exampleInputs[3] {
+		t.Errorf("written input = %q; expected %q", data, exampleInputs[3])
+	}
+
+	// the fetched input is immediately solvable
+	var out bytes.Buffer
+	if err := runCommand(&out, []string{"--dir", dir, "--day", "3", "--part", "1"}); err != nil {
+		t.Fatalf("runCommand failed: %v", err)
+	}
+	if out.String() != "Day 3 part 1: 357\n" {
+		t.Errorf("runCommand output = %q; expected %q", out.String(), "Day 3 part 1: 357\n")
+	}
+}
+
+// bad session
+func TestFetchCommandUnauthorized(t *testing.T) {
+	srv := clienttest.NewServer("secret", map[int]string{1: exampleInputs[1]})
+	defer srv.Close()
+
+	dir, err := os.MkdirTemp("", "test_fetch_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var out bytes.Buffer
+	err = fetchCommand(&out, []string{
+		"--day", "1",
+		"--dir", dir,
+		"--session", "wrong",
+		"--base-url", srv.URL,
+		"--cache", filepath.Join(dir, "cache"),
+	})
+	if err == nil {
+		t.Errorf("fetchCommand with wrong session expected error but got none")
+	}
+	if _, err := os.Stat(defaultInputPath(dir, 1)); err == nil {
+		t.Errorf("input written despite failed fetch")
+	}
+}
@@ -0,0 +1,49 @@
+/**
+ * Advent of Code 2025 - aoc Command: gen
+ *
+ * Writes a seeded synthetic input for a day, for stress-testing a
+ * solver on inputs far larger than the worked examples.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"solver/gen"
+)
+
+// generates one day's input to stdout or a file
+func genCommand(w io.Writer, args []string) error {
+	fs := flag.NewFlagSet("gen", flag.ContinueOnError)
+	fs.SetOutput(w)
+	day := fs.Int("day", 0, "day to generate an input for")
+	seed := fs.Uint64("seed", 1, "random seed; the same seed and size give the same input")
+	size := fs.Int("size", 0, "main dimension, e.g. rotations or grid side (0 for the day's default)")
+	out := fs.String("out", "-", "output file, - for stdout")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *day == 0 {
+		return fmt.Errorf("--day is required")
+	}
+
+	input, err := gen.Day(*day, *seed, *size)
+	if err != nil {
+		return err
+	}
+
+	if *out == "-" {
+		_, err := io.WriteString(w, input)
+		return err
+	}
+	return os.WriteFile(*out, []byte(input), 0o644)
+}
@@ -0,0 +1,70 @@
+/**
+ * Test suite for Advent of Code 2025 - aoc Command: gen
+ *
+ * Tests verify generated inputs are valid for every registered solver.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"solver"
+	"solver/gen"
+)
+
+// every registered day parses and solves its generated input
+func TestGeneratedInputs(t *testing.T) {
+	for _, s := range solver.All() {
+		input, err := gen.Day(s.Day(), 1, 0)
+		if err != nil {
+			t.Fatalf("day %d: gen.Day failed: %v", s.Day(), err)
+		}
+
+		for _, part := range []int{1, 2} {
+			if _, err := solver.Solve(s, strings.NewReader(input), part); err != nil {
+				t.Errorf("day %d part %d: Solve on generated input failed: %v", s.Day(), part, err)
+			}
+		}
+	}
+}
+
+// stdout and file output match for the same seed
+func TestGenCommand(t *testing.T) {
+	dir, err := os.MkdirTemp("", "test_aoc_gen_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var out bytes.Buffer
+	if err := genCommand(&out, []string{"--day", "1", "--size", "10", "--seed", "5"}); err != nil {
+		t.Fatalf("genCommand failed: %v", err)
+	}
+	if n := strings.Count(out.String(), "\n"); n != 10 {
+		t.Errorf("generated %d rotations; expected 10", n)
+	}
+
+	filename := filepath.Join(dir, "input.txt")
+	if err := genCommand(&out, []string{"--day", "1", "--size", "10", "--seed", "5", "--out", filename}); err != nil {
+		t.Fatalf("genCommand --out failed: %v", err)
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read generated file: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), string(data)) {
+		t.Errorf("file output %q differs from stdout %q", data, out.String())
+	}
+
+	if err := genCommand(&out, nil); err == nil {
+		t.Errorf("genCommand without --day expected error but got none")
+	}
+}
@@ -0,0 +1,23 @@
+module aoc
+
+go 1.24.1
+
+require (
+	day1 v0.0.0
+	day2 v0.0.0
+	day3 v0.0.0
+	day4 v0.0.0
+	day5 v0.0.0
+	day6 v0.0.0
+	solver v0.0.0
+)
+
+replace (
+	day1 => ../day1
+	day2 => ../day2
+	day3 => ../day3
+	day4 => ../day4
+	day5 => ../day5
+	day6 => ../day6
+	solver => ../solver
+)
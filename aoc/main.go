@@ -0,0 +1,70 @@
+/**
+ * Advent of Code 2025 - aoc Command
+ *
+ * Single entry point for every day's solver. Subcommands are
+ * dispatched by name, e.g. `aoc run --day 3 --part 2`.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// command is a named subcommand taking its own flag arguments
+type command struct {
+	summary string
+	run     func(w io.Writer, args []string) error
+}
+
+var commands = map[string]command{
+	"bench":    {"benchmark solvers and compare with a baseline", benchCommand},
+	"examples": {"extract worked examples into test fixtures", examplesCommand},
+	"fetch":    {"download puzzle inputs into the day folders", fetchCommand},
+	"gen":      {"write a seeded synthetic input for a day", genCommand},
+	"run":      {"solve one day, one part or every day", runCommand},
+	"submit":   {"post an answer and record the verdict", submitCommand},
+	"verify":   {"check answers against answers.json", verifyCommand},
+}
+
+// prints the list of available subcommands
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: aoc <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-8s %s\n", name, commands[name].summary)
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", os.Args[1])
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+
+	if err := cmd.run(os.Stdout, os.Args[2:]); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,58 @@
+/**
+ * Advent of Code 2025 - aoc Command: run
+ *
+ * Solves any subset of days and parts. With no --day every
+ * registered day is run, reading each input from its day folder.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"solver"
+)
+
+// runs the selected solvers and prints one line per answer
+func runCommand(w io.Writer, args []string) error {
+	var sel selection
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	fs.SetOutput(w)
+	sel.register(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	selected, parts, err := sel.resolve()
+	if err != nil {
+		return err
+	}
+
+	for _, s := range selected {
+		data, err := readInput(sel.inputPath(s.Day()))
+		if err != nil {
+			return err
+		}
+
+		p, err := parseInput(s, data)
+		if err != nil {
+			return err
+		}
+
+		for _, n := range parts {
+			answer, err := solver.Part(p, n)
+			if err != nil {
+				return fmt.Errorf("day %d part %d: %w", s.Day(), n, err)
+			}
+			fmt.Fprintf(w, "Day %d part %d: %s\n", s.Day(), n, answer)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+/**
+ * Test suite for Advent of Code 2025 - aoc Command: run
+ *
+ * Tests verify the runner dispatches to the right solver for each day.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// run single day with explicit input
+func TestRunCommandSingleDay(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "test_run_day1_*.txt")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.WriteString("L68\nL30\nR48\nL5\nR60\nL55\nL1\nL99\nR14\nL82\n"); err != nil {
+		t.Fatalf("Failed to write to temp file: %v", err)
+	}
+	tmpFile.Close()
+
+	var out bytes.Buffer
+	if err := runCommand(&out, []string{"--day", "1", "--input", tmpFile.Name()}); err != nil {
+		t.Fatalf("runCommand failed: %v", err)
+	}
+
+	expected := "Day 1 part 1: 3\nDay 1 part 2: 6\n"
+	if out.String() != expected {
+		t.Errorf("runCommand output = %q; expected %q", out.String(), expected)
+	}
+}
+
+// run every day from a repository layout
+func TestRunCommandAllDays(t *testing.T) {
+	dir := writeExampleInputs(t)
+	defer os.RemoveAll(dir)
+
+	var out bytes.Buffer
+	if err := runCommand(&out, []string{"--dir", dir, "--part", "1"}); err != nil {
+		t.Fatalf("runCommand failed: %v", err)
+	}
+
+	expected := "Day 1 part 1: 3\n" +
+		"Day 2 part 1: 1142\n" +
+		"Day 3 part 1: 357\n" +
+		"Day 4 part 1: 13\n" +
+		"Day 5 part 1: 3\n" +
+		"Day 6 part 1: 4277556\n"
+	if out.String() != expected {
+		t.Errorf("runCommand output = %q; expected %q", out.String(), expected)
+	}
+}
+
+// input flag without a day
+func TestRunCommandInputNeedsDay(t *testing.T) {
+	var out bytes.Buffer
+	if err := runCommand(&out, []string{"--input", "input.txt"}); err == nil {
+		t.Errorf("runCommand with --input and no --day expected error but got none")
+	}
+}
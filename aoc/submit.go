@@ -0,0 +1,152 @@
+/**
+ * Advent of Code 2025 - aoc Command: submit
+ *
+ * Solves one part and posts the answer, refusing locally when the
+ * attempts log shows it cannot be right or a cooldown has not ended.
+ * Accepted answers are also recorded in answers.json for `aoc verify`.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"aoc/answers"
+	"aoc/attempts"
+	"aoc/client"
+	"solver"
+)
+
+// submits the answer for one part of one day
+func submitCommand(w io.Writer, args []string) error {
+	var sel selection
+	var cf clientFlags
+	fs := flag.NewFlagSet("submit", flag.ContinueOnError)
+	fs.SetOutput(w)
+	sel.register(fs)
+	cf.register(fs)
+	answerFlag := fs.String("answer", "", "answer to submit instead of solving the input")
+	attemptsPath := fs.String("attempts", "", "attempts log (default attempts.json in the site and session's cache dir)")
+	answersPath := fs.String("answers", "", "answers file to record accepted answers (default <dir>/answers.json)")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if sel.day == 0 || sel.part == 0 {
+		return fmt.Errorf("submit requires --day and --part")
+	}
+	if _, err := selectParts(sel.part); err != nil {
+		return err
+	}
+
+	c := cf.client()
+	if *attemptsPath == "" {
+		if c.CacheDir == "" {
+			return fmt.Errorf("no cache directory for the attempts log; pass --cache or --attempts")
+		}
+		*attemptsPath = filepath.Join(c.StateDir(), "attempts.json")
+	}
+
+	answer := *answerFlag
+	inputHash := ""
+	if answer == "" {
+		s, ok := solver.Lookup(sel.day)
+		if !ok {
+			return fmt.Errorf("no solver registered for day %d", sel.day)
+		}
+
+		data, err := readInput(sel.inputPath(sel.day))
+		if err != nil {
+			return err
+		}
+
+		p, err := parseInput(s, data)
+		if err != nil {
+			return err
+		}
+
+		result, err := solver.Part(p, sel.part)
+		if err != nil {
+			return fmt.Errorf("day %d part %d: %w", sel.day, sel.part, err)
+		}
+
+		answer = result.String()
+		inputHash = answers.HashInput(data)
+	}
+
+	log, err := attempts.Load(*attemptsPath)
+	if err != nil {
+		return err
+	}
+
+	if a, ok := log.Solved(sel.day, sel.part); ok {
+		fmt.Fprintf(w, "Day %d part %d: already solved with %s\n", sel.day, sel.part, a.Answer)
+		return nil
+	}
+
+	if a, ok := log.RuledOut(sel.day, sel.part, answer); ok {
+		return fmt.Errorf("not submitting %s: earlier answer %s was %s", answer, a.Answer, a.Verdict)
+	}
+
+	if until, ok := log.CoolingDown(sel.day, sel.part, time.Now()); ok {
+		return fmt.Errorf("not submitting %s: wait %s for the cooldown to end", answer, time.Until(until).Round(time.Second))
+	}
+
+	result, err := c.Submit(context.Background(), sel.day, sel.part, answer)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "Day %d part %d: %s is %s\n", sel.day, sel.part, answer, result.Verdict)
+	if result.Wait > 0 {
+		fmt.Fprintf(w, "Wait %s before submitting again\n", result.Wait)
+	}
+
+	if result.Verdict == client.Unknown {
+		return fmt.Errorf("unrecognised reply: %s", result.Message)
+	}
+
+	if result.Verdict.Judged() || result.Wait > 0 {
+		now := time.Now().UTC()
+		a := attempts.Attempt{
+			Day:     sel.day,
+			Part:    sel.part,
+			Answer:  answer,
+			Verdict: result.Verdict,
+			Time:    now,
+		}
+		if result.Wait > 0 {
+			a.Until = now.Add(result.Wait)
+		}
+		log.Add(a)
+		if err := log.Save(); err != nil {
+			return err
+		}
+	}
+
+	if result.Verdict == client.Correct && inputHash != "" {
+		if *answersPath == "" {
+			*answersPath = filepath.Join(sel.dir, "answers.json")
+		}
+
+		store, err := answers.Load(*answersPath)
+		if err != nil {
+			return err
+		}
+		store.Set(answers.Key{Day: sel.day, Part: sel.part, Input: inputHash}, answer)
+		if err := store.Save(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
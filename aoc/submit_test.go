@@ -0,0 +1,169 @@
+/**
+ * Test suite for Advent of Code 2025 - aoc Command: submit
+ *
+ * Tests submit example answers to the fake site and check that
+ * attempts are recorded and repeated wrong answers are refused.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"aoc/answers"
+	"aoc/client/clienttest"
+)
+
+// common flags pointing submit at the fake site and a temp checkout
+func submitArgs(srv *clienttest.Server, dir string, extra ...string) []string {
+	args := []string{
+		"--dir", dir,
+		"--session", "secret",
+		"--base-url", srv.URL,
+		"--cache", filepath.Join(dir, "cache"),
+		"--min-interval", "0",
+	}
+	return append(args, extra...)
+}
+
+// wrong answer then refused resubmission
+func TestSubmitCommandRefusesRepeats(t *testing.T) {
+	srv := clienttest.NewServer("secret", nil)
+	defer srv.Close()
+	srv.SetAnswer(1, 1, "1150")
+
+	dir, err := os.MkdirTemp("", "test_submit_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var out bytes.Buffer
+	if err := submitCommand(&out, submitArgs(srv, dir, "--day", "1", "--part", "1", "--answer", "2000")); err != nil {
+		t.Fatalf("submitCommand failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "2000 is too high") {
+		t.Errorf("submit output = %q; expected too high", out.String())
+	}
+
+	for _, answer := range []string{"2000", "3000"} {
+		out.Reset()
+		if err := submitCommand(&out, submitArgs(srv, dir, "--day", "1", "--part", "1", "--answer", answer)); err == nil {
+			t.Errorf("submitCommand(%s) after too high expected error but got none", answer)
+		}
+	}
+
+	if n := len(srv.Submissions()); n != 1 {
+		t.Errorf("site received %d submissions; expected 1", n)
+	}
+}
+
+// solved answer is recorded
+func TestSubmitCommandCorrect(t *testing.T) {
+	srv := clienttest.NewServer("secret", nil)
+	defer srv.Close()
+	srv.SetAnswer(5, 2, "14")
+
+	dir := writeExampleInputs(t)
+	defer os.RemoveAll(dir)
+
+	var out bytes.Buffer
+	if err := submitCommand(&out, submitArgs(srv, dir, "--day", "5", "--part", "2")); err != nil {
+		t.Fatalf("submitCommand failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "14 is correct") {
+		t.Errorf("submit output = %q; expected correct", out.String())
+	}
+
+	store, err := answers.Load(filepath.Join(dir, "answers.json"))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	hash := answers.HashInput([]byte(exampleInputs[5]))
+	if got, ok := store.Get(answers.Key{Day: 5, Part: 2, Input: hash}); !ok || got != "14" {
+		t.Errorf("answers.json entry = %q, %v; expected 14", got, ok)
+	}
+
+	out.Reset()
+	if err := submitCommand(&out, submitArgs(srv, dir, "--day", "5", "--part", "2")); err != nil {
+		t.Fatalf("submitCommand after solving failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "already solved with 14") {
+		t.Errorf("submit output = %q; expected already solved", out.String())
+	}
+	if n := len(srv.Submissions()); n != 1 {
+		t.Errorf("site received %d submissions; expected 1", n)
+	}
+}
+
+// cooldown is waited out locally, and does not rule the answer out
+func TestSubmitCommandTooSoon(t *testing.T) {
+	srv := clienttest.NewServer("secret", nil)
+	defer srv.Close()
+	srv.SetAnswer(2, 1, "1142")
+	srv.SetCooldown(1)
+
+	dir := writeExampleInputs(t)
+	defer os.RemoveAll(dir)
+
+	var out bytes.Buffer
+	if err := submitCommand(&out, submitArgs(srv, dir, "--day", "2", "--part", "1")); err != nil {
+		t.Fatalf("submitCommand failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "Wait 1s") {
+		t.Errorf("submit output = %q; expected wait notice", out.String())
+	}
+
+	// refused without asking the site until the cooldown ends
+	out.Reset()
+	if err := submitCommand(&out, submitArgs(srv, dir, "--day", "2", "--part", "1")); err == nil {
+		t.Errorf("submitCommand during cooldown expected error but got none")
+	}
+	if n := len(srv.Submissions()); n != 1 {
+		t.Errorf("site received %d submissions during cooldown; expected 1", n)
+	}
+
+	// the cooldown ends; the same answer may now be sent and is accepted
+	time.Sleep(time.Second)
+	srv.SetCooldown(0)
+	out.Reset()
+	if err := submitCommand(&out, submitArgs(srv, dir, "--day", "2", "--part", "1")); err != nil {
+		t.Fatalf("submitCommand after cooldown failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "1142 is correct") {
+		t.Errorf("submit output = %q; expected correct", out.String())
+	}
+}
+
+// day and part are required
+func TestSubmitCommandNeedsDayAndPart(t *testing.T) {
+	var out bytes.Buffer
+	if err := submitCommand(&out, []string{"--day", "1"}); err == nil {
+		t.Errorf("submitCommand without --part expected error but got none")
+	}
+}
+
+// without a cache directory the attempts log needs a path
+func TestSubmitCommandNeedsAttemptsLog(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", "")
+	t.Setenv("HOME", "")
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	var out bytes.Buffer
+	err := submitCommand(&out, []string{"--day", "1", "--part", "1", "--answer", "42", "--session", "secret"})
+	if err == nil {
+		t.Errorf("submitCommand without a cache expected error but got none")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "attempts.json")); err == nil {
+		t.Errorf("submitCommand wrote attempts.json in the working directory")
+	}
+}
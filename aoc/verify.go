@@ -0,0 +1,109 @@
+/**
+ * Advent of Code 2025 - aoc Command: verify
+ *
+ * Re-runs solvers and compares every answer with the one recorded
+ * for the same input in answers.json, so refactors that change a
+ * result are caught instead of silently shipped.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"aoc/answers"
+	"solver"
+)
+
+// checks the selected solvers against the answers file
+func verifyCommand(w io.Writer, args []string) error {
+	var sel selection
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	fs.SetOutput(w)
+	sel.register(fs)
+	answersPath := fs.String("answers", "", "answers file (default <dir>/answers.json)")
+	record := fs.Bool("record", false, "record answers that have no entry yet")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	selected, parts, err := sel.resolve()
+	if err != nil {
+		return err
+	}
+
+	if *answersPath == "" {
+		*answersPath = filepath.Join(sel.dir, "answers.json")
+	}
+
+	store, err := answers.Load(*answersPath)
+	if err != nil {
+		return err
+	}
+
+	mismatches, recorded := 0, 0
+
+	for _, s := range selected {
+		filename := sel.inputPath(s.Day())
+		data, err := readInput(filename)
+		if errors.Is(err, os.ErrNotExist) && sel.input == "" {
+			fmt.Fprintf(w, "Day %d: skipped, no input at %s\n", s.Day(), filename)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		p, err := parseInput(s, data)
+		if err != nil {
+			return err
+		}
+
+		hash := answers.HashInput(data)
+		for _, n := range parts {
+			answer, err := solver.Part(p, n)
+			if err != nil {
+				return fmt.Errorf("day %d part %d: %w", s.Day(), n, err)
+			}
+
+			got := answer.String()
+			key := answers.Key{Day: s.Day(), Part: n, Input: hash}
+			want, ok := store.Get(key)
+
+			switch {
+			case ok && want == got:
+				fmt.Fprintf(w, "Day %d part %d: ok %s\n", s.Day(), n, got)
+			case ok:
+				mismatches++
+				fmt.Fprintf(w, "Day %d part %d: MISMATCH got %s, recorded %s\n", s.Day(), n, got, want)
+			case *record:
+				recorded++
+				store.Set(key, got)
+				fmt.Fprintf(w, "Day %d part %d: recorded %s\n", s.Day(), n, got)
+			default:
+				fmt.Fprintf(w, "Day %d part %d: unverified %s (no recorded answer)\n", s.Day(), n, got)
+			}
+		}
+	}
+
+	if recorded > 0 {
+		if err := store.Save(); err != nil {
+			return err
+		}
+	}
+
+	if mismatches > 0 {
+		return fmt.Errorf("%d answer(s) differ from %s", mismatches, *answersPath)
+	}
+
+	return nil
+}
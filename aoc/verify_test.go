@@ -0,0 +1,98 @@
+/**
+ * Test suite for Advent of Code 2025 - aoc Command: verify
+ *
+ * Tests verify answers are recorded, confirmed and flagged when a
+ * solver's result changes.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"aoc/answers"
+)
+
+// record then verify
+func TestVerifyCommandRecordAndCheck(t *testing.T) {
+	dir := writeExampleInputs(t)
+	defer os.RemoveAll(dir)
+
+	var out bytes.Buffer
+	if err := verifyCommand(&out, []string{"--dir", dir, "--day", "1"}); err != nil {
+		t.Fatalf("verifyCommand failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "Day 1 part 1: unverified 3") {
+		t.Errorf("verify without answers output = %q; expected unverified", out.String())
+	}
+
+	out.Reset()
+	if err := verifyCommand(&out, []string{"--dir", dir, "--record"}); err != nil {
+		t.Fatalf("verifyCommand --record failed: %v", err)
+	}
+
+	store, err := answers.Load(filepath.Join(dir, "answers.json"))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(store.Entries()) != 12 {
+		t.Errorf("recorded %d answers; expected 12", len(store.Entries()))
+	}
+
+	out.Reset()
+	if err := verifyCommand(&out, []string{"--dir", dir}); err != nil {
+		t.Fatalf("verifyCommand after record failed: %v\n%s", err, out.String())
+	}
+	if strings.Count(out.String(), ": ok ") != 12 {
+		t.Errorf("verify output = %q; expected 12 ok lines", out.String())
+	}
+}
+
+// changed answer
+func TestVerifyCommandMismatch(t *testing.T) {
+	dir := writeExampleInputs(t)
+	defer os.RemoveAll(dir)
+
+	store, err := answers.Load(filepath.Join(dir, "answers.json"))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	hash := answers.HashInput([]byte(exampleInputs[5]))
+	store.Set(answers.Key{Day: 5, Part: 2, Input: hash}, "15")
+	if err := store.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	var out bytes.Buffer
+	err = verifyCommand(&out, []string{"--dir", dir, "--day", "5"})
+	if err == nil {
+		t.Fatalf("verifyCommand expected mismatch error but got none")
+	}
+	if !strings.Contains(out.String(), "Day 5 part 2: MISMATCH got 14, recorded 15") {
+		t.Errorf("verify output = %q; expected mismatch line", out.String())
+	}
+}
+
+// missing inputs are skipped
+func TestVerifyCommandSkipsMissingInput(t *testing.T) {
+	dir, err := os.MkdirTemp("", "test_verify_empty_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var out bytes.Buffer
+	if err := verifyCommand(&out, []string{"--dir", dir, "--day", "2"}); err != nil {
+		t.Fatalf("verifyCommand failed: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "Day 2: skipped") {
+		t.Errorf("verify output = %q; expected skipped", out.String())
+	}
+}
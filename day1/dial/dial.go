@@ -0,0 +1,206 @@
+/**
+ * Advent of Code 2025 - Day 1: North Pole Security Dial
+ *
+ * This package solves the dial password problem by simulating
+ * rotations and counting how many times the dial points at zero.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// Rotation represents a single dial rotation instruction
+type Rotation struct {
+	Direction byte // 'L' for left, 'R' for right, Set for an absolute set
+	Distance  int  // Number of clicks to rotate, or the position to set
+}
+
+// reads and parses rotation instructions from file, see Parse
+func ReadRotations(filename string) ([]Rotation, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+// parses rotations from any reader: one per line in the puzzle format,
+// or anything the extended grammar accepts (see ParseFunc)
+func Parse(r io.Reader) ([]Rotation, error) {
+	var rotations []Rotation
+	err := parseItems(r, false, expandBounded(func(m Move) error {
+		rotations = append(rotations, m.Rotation)
+		return nil
+	}))
+	if errors.Is(err, errTooMany) {
+		return nil, fmt.Errorf("repeats expand to more than %d rotations", maxRotations)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return rotations, nil
+}
+
+// converts line like "L68" or "R48" to a struct
+func ParseRotation(line string) (Rotation, error) {
+	if len(line) < 2 {
+		return Rotation{}, fmt.Errorf("invalid rotation format: %s", line)
+	}
+
+	direction := line[0]
+	if direction != 'L' && direction != 'R' {
+		return Rotation{}, fmt.Errorf("invalid direction: %c", direction)
+	}
+
+	distance, err := strconv.Atoi(line[1:])
+	if err != nil {
+		return Rotation{}, fmt.Errorf("invalid distance: %s", line[1:])
+	}
+
+	return Rotation{Direction: direction, Distance: distance}, nil
+}
+
+// Method decides which clicks of a rotation can count as a hit
+type Method int
+
+const (
+	EndOfRotation Method = iota // only where each rotation stops (p1)
+	EveryClick                  // every click, mid-rotation too (p2)
+)
+
+// Dial is a circular dial with positions 0 to Size-1 that counts how
+// often it points at one of its targets
+type Dial struct {
+	Size    int   // number of positions
+	Start   int   // position before the first rotation
+	Targets []int // positions that count as a hit
+	Method  Method
+}
+
+// the North Pole safe: 100 positions, starting at 50, counting 0
+func SafeDial(method Method) Dial {
+	return Dial{Size: 100, Start: 50, Targets: []int{0}, Method: method}
+}
+
+// checks the dial has positions and that start and targets are on it
+func (d Dial) Validate() error {
+	if d.Size < 1 {
+		return fmt.Errorf("invalid dial size %d", d.Size)
+	}
+	if d.Start < 0 || d.Start >= d.Size {
+		return fmt.Errorf("start %d is not on a %d-position dial", d.Start, d.Size)
+	}
+
+	seen := make(map[int]bool)
+	for _, t := range d.Targets {
+		if t < 0 || t >= d.Size {
+			return fmt.Errorf("target %d is not on a %d-position dial", t, d.Size)
+		}
+		if seen[t] {
+			return fmt.Errorf("duplicate target %d", t)
+		}
+		seen[t] = true
+	}
+
+	return nil
+}
+
+// turns the dial from position, returning where it stops and the hits scored
+func (d Dial) Turn(position int, rotation Rotation) (int, int) {
+	clicks := rotation.Distance
+	if rotation.Direction == 'L' {
+		clicks = -clicks
+	} else if rotation.Direction == Set {
+		// moves the dial without clicking, so it never scores
+		return mod(rotation.Distance, d.Size), 0
+	} else if rotation.Direction != 'R' {
+		return position, 0
+	}
+	// a negative distance turns the other way when only stops count but
+	// never clicks when every click counts, as both parts always had it
+	if rotation.Distance < 0 && d.Method == EveryClick {
+		return position, 0
+	}
+
+	end := mod(position+clicks, d.Size)
+	hits := 0
+
+	switch d.Method {
+	case EndOfRotation:
+		for _, t := range d.Targets {
+			if end == t {
+				hits++
+			}
+		}
+	case EveryClick:
+		for _, t := range d.Targets {
+			hits += d.clicksOnto(position, clicks, t)
+		}
+	}
+
+	return end, hits
+}
+
+// counts the clicks of a turn from position that land on target: the
+// first comes once the gap to it is closed (a full turn when already
+// there), then one every full turn after that
+func (d Dial) clicksOnto(position, clicks, target int) int {
+	gap := mod(target-position, d.Size)
+	if clicks < 0 {
+		clicks = -clicks
+		gap = mod(position-target, d.Size)
+	}
+	if gap == 0 {
+		gap = d.Size
+	}
+
+	if clicks < gap {
+		return 0
+	}
+	return 1 + (clicks-gap)/d.Size
+}
+
+// applies every rotation from the start position and totals the hits
+func (d Dial) Count(rotations []Rotation) (int, error) {
+	c, err := d.NewCounter()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, rotation := range rotations {
+		c.Add(rotation)
+	}
+
+	return c.Total, nil
+}
+
+// non-negative remainder of a divided by n
+func mod(a, n int) int {
+	return (a%n + n) % n
+}
+
+// counts how many times the dial ends at position 0
+// after each complete rotation (p1)
+func SimulateDialPart1(rotations []Rotation) int {
+	count, _ := SafeDial(EndOfRotation).Count(rotations) // always valid
+	return count
+}
+
+// counts every time the dial points at 0 during any rotation,
+// including intermediate positions (p2)
+func SimulateDialPart2(rotations []Rotation) int {
+	count, _ := SafeDial(EveryClick).Count(rotations) // always valid
+	return count
+}
@@ -0,0 +1,421 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 1: North Pole Security Dial
+ *
+ * Tests verify the dial rotation simulation and password counting logic.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"errors"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// parse rotation
+func TestParseRotation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Rotation
+		hasError bool
+	}{
+		{"L68", Rotation{Direction: 'L', Distance: 68}, false},
+		{"R48", Rotation{Direction: 'R', Distance: 48}, false},
+		{"L1", Rotation{Direction: 'L', Distance: 1}, false},
+		{"R100", Rotation{Direction: 'R', Distance: 100}, false},
+		{"L", Rotation{}, true},    // Too short
+		{"X50", Rotation{}, true},  // Invalid direction
+		{"Labc", Rotation{}, true}, // Invalid distance
+		{"", Rotation{}, true},     // Empty string
+	}
+
+	for _, test := range tests {
+		result, err := ParseRotation(test.input)
+
+		if test.hasError {
+			if err == nil {
+				t.Errorf("ParseRotation(%q) expected error but got none", test.input)
+			}
+		} else {
+			if err != nil {
+				t.Errorf("ParseRotation(%q) unexpected error: %v", test.input, err)
+			}
+			if result != test.expected {
+				t.Errorf("ParseRotation(%q) = %+v; expected %+v", test.input, result, test.expected)
+			}
+		}
+	}
+}
+
+// simulate p1
+func TestSimulateDialPart1(t *testing.T) {
+	// Example from the problem description
+	rotations := []Rotation{
+		{'L', 68}, {'L', 30}, {'R', 48}, {'L', 5}, {'R', 60},
+		{'L', 55}, {'L', 1}, {'L', 99}, {'R', 14}, {'L', 82},
+	}
+
+	result := SimulateDialPart1(rotations)
+	expected := 3
+
+	if result != expected {
+		t.Errorf("SimulateDialPart1() = %d; expected %d", result, expected)
+	}
+}
+
+// simulate p2
+func TestSimulateDialPart2(t *testing.T) {
+	// Example from the problem description
+	rotations := []Rotation{
+		{'L', 68}, {'L', 30}, {'R', 48}, {'L', 5}, {'R', 60},
+		{'L', 55}, {'L', 1}, {'L', 99}, {'R', 14}, {'L', 82},
+	}
+
+	result := SimulateDialPart2(rotations)
+	expected := 6
+
+	if result != expected {
+		t.Errorf("SimulateDialPart2() = %d; expected %d", result, expected)
+	}
+}
+
+// edge p2
+func TestSimulateDialPart2EdgeCase(t *testing.T) {
+	// Test case where a single large rotation causes multiple zeros
+	rotations := []Rotation{
+		{'R', 150}, // Should pass through zero multiple times
+	}
+
+	result := SimulateDialPart2(rotations)
+	// Starting at 50, rotating R150 passes through 0 at step 50 and step 150
+	// So we expect to hit 0 twice during this rotation
+	expected := 2
+
+	if result != expected {
+		t.Errorf("SimulateDialPart2 edge case = %d; expected %d", result, expected)
+	}
+}
+
+// huge distances are counted without stepping
+func TestSimulateDialPart2HugeDistance(t *testing.T) {
+	rotations := []Rotation{
+		{'R', 1_000_000_000}, // 50 -> 50, ten million zeros
+		{'L', 1_000_000_050}, // 50 -> 0, ten million and one zeros
+	}
+
+	result := SimulateDialPart2(rotations)
+	expected := 20_000_001
+
+	if result != expected {
+		t.Errorf("SimulateDialPart2 huge distance = %d; expected %d", result, expected)
+	}
+}
+
+// click-by-click reference for p2, the original implementation
+func bruteForcePart2(rotations []Rotation) int {
+	position := 50
+	zeroCount := 0
+
+	for _, rotation := range rotations {
+		step := 0
+		if rotation.Direction == 'L' {
+			step = 99
+		} else if rotation.Direction == 'R' {
+			step = 1
+		}
+		for i := 1; i <= rotation.Distance && step != 0; i++ {
+			position = (position + step) % 100
+			if position == 0 {
+				zeroCount++
+			}
+		}
+	}
+
+	return zeroCount
+}
+
+// p2 arithmetic against the brute force on random rotations
+func TestSimulateDialPart2MatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewPCG(11, 11))
+
+	for trial := 0; trial < 500; trial++ {
+		rotations := make([]Rotation, rng.IntN(20))
+		for i := range rotations {
+			rotations[i] = Rotation{Direction: "LR"[rng.IntN(2)], Distance: rng.IntN(1000) - 100}
+		}
+
+		got, want := SimulateDialPart2(rotations), bruteForcePart2(rotations)
+		if got != want {
+			t.Fatalf("SimulateDialPart2(%v) = %d; brute force gives %d", rotations, got, want)
+		}
+	}
+}
+
+// p2 arithmetic against the brute force on fuzzed rotations
+func FuzzSimulateDialPart2(f *testing.F) {
+	f.Add([]byte{'L', 68, 'R', 48, 'L', 0})
+	f.Add([]byte{'R', 50, 'L', 100, 'L', 200})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// each pair of bytes is a direction and a distance scaled up
+		// past a full turn
+		var rotations []Rotation
+		for i := 0; i+1 < len(data); i += 2 {
+			direction := byte('L')
+			if data[i]%2 == 1 {
+				direction = 'R'
+			}
+			rotations = append(rotations, Rotation{Direction: direction, Distance: int(data[i+1]) * 7})
+		}
+
+		got, want := SimulateDialPart2(rotations), bruteForcePart2(rotations)
+		if got != want {
+			t.Errorf("SimulateDialPart2(%v) = %d; brute force gives %d", rotations, got, want)
+		}
+	})
+}
+
+// click-by-click reference for any dial configuration
+func clickByClick(d Dial, rotations []Rotation) int {
+	position, total := d.Start, 0
+
+	for _, rotation := range rotations {
+		step, clicks := 1, rotation.Distance
+		if rotation.Direction == 'L' {
+			step = -1
+		}
+		if clicks < 0 {
+			// the original p2 loop never ran backwards
+			if d.Method == EveryClick {
+				continue
+			}
+			step, clicks = -step, -clicks
+		}
+
+		for i := 1; i <= clicks; i++ {
+			position = mod(position+step, d.Size)
+			if d.Method == EveryClick || i == clicks {
+				for _, t := range d.Targets {
+					if position == t {
+						total++
+					}
+				}
+			}
+		}
+		if clicks == 0 && d.Method == EndOfRotation {
+			for _, t := range d.Targets {
+				if position == t {
+					total++
+				}
+			}
+		}
+	}
+
+	return total
+}
+
+// custom dials
+func TestDialCount(t *testing.T) {
+	rotations := []Rotation{{'R', 3}, {'L', 7}, {'R', 12}}
+
+	tests := []struct {
+		name     string
+		dial     Dial
+		expected int
+	}{
+		{"one position, ends", Dial{Size: 1, Targets: []int{0}, Method: EndOfRotation}, 3},
+		{"one position, clicks", Dial{Size: 1, Targets: []int{0}, Method: EveryClick}, 22},
+		{"no targets", Dial{Size: 10, Start: 5}, 0},
+		{"two targets, ends", Dial{Size: 10, Start: 0, Targets: []int{3, 8}, Method: EndOfRotation}, 2},
+		{"two targets, clicks", Dial{Size: 10, Start: 0, Targets: []int{3, 8}, Method: EveryClick}, 5},
+		{"safe p1", SafeDial(EndOfRotation), 0},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.dial.Count(rotations)
+		if err != nil {
+			t.Fatalf("%s: Count failed: %v", tt.name, err)
+		}
+		if got != tt.expected {
+			t.Errorf("%s: Count() = %d; expected %d", tt.name, got, tt.expected)
+		}
+		if ref := clickByClick(tt.dial, rotations); got != ref {
+			t.Errorf("%s: Count() = %d; click-by-click gives %d", tt.name, got, ref)
+		}
+	}
+}
+
+// invalid dials
+func TestDialValidate(t *testing.T) {
+	tests := []Dial{
+		{Size: 0},
+		{Size: 10, Start: 10},
+		{Size: 10, Start: -1},
+		{Size: 10, Targets: []int{10}},
+		{Size: 10, Targets: []int{3, 3}},
+	}
+
+	for _, d := range tests {
+		if _, err := d.Count(nil); err == nil {
+			t.Errorf("Count with %+v expected error but got none", d)
+		}
+	}
+}
+
+// turn reports the stop position and hits
+func TestDialTurn(t *testing.T) {
+	d := SafeDial(EveryClick)
+
+	end, hits := d.Turn(50, Rotation{'L', 250})
+	if end != 0 || hits != 3 {
+		t.Errorf("Turn(50, L250) = %d, %d; expected 0, 3", end, hits)
+	}
+
+	// p2 never clicks backwards, p1 turns the other way
+	end, hits = d.Turn(0, Rotation{'R', -1})
+	if end != 0 || hits != 0 {
+		t.Errorf("Turn(0, R-1) = %d, %d; expected 0, 0", end, hits)
+	}
+	end, hits = SafeDial(EndOfRotation).Turn(1, Rotation{'R', -1})
+	if end != 0 || hits != 1 {
+		t.Errorf("part 1 Turn(1, R-1) = %d, %d; expected 0, 1", end, hits)
+	}
+}
+
+// any configuration against the click-by-click reference
+func TestDialMatchesClickByClick(t *testing.T) {
+	rng := rand.New(rand.NewPCG(12, 12))
+
+	for trial := 0; trial < 500; trial++ {
+		size := 1 + rng.IntN(30)
+		d := Dial{Size: size, Start: rng.IntN(size), Method: Method(rng.IntN(2))}
+		for _, p := range rng.Perm(size)[:rng.IntN(min(size, 4)+1)] {
+			d.Targets = append(d.Targets, p)
+		}
+
+		rotations := make([]Rotation, rng.IntN(15))
+		for i := range rotations {
+			rotations[i] = Rotation{Direction: "LR"[rng.IntN(2)], Distance: rng.IntN(200) - 20}
+		}
+
+		got, err := d.Count(rotations)
+		if err != nil {
+			t.Fatalf("Count(%+v) failed: %v", d, err)
+		}
+		if want := clickByClick(d, rotations); got != want {
+			t.Fatalf("%+v Count(%v) = %d; click-by-click gives %d", d, rotations, got, want)
+		}
+	}
+}
+
+// dial wrap
+func TestDialWrapping(t *testing.T) {
+	// Test that position calculations handle wrapping
+	rotations := []Rotation{
+		{'R', 50}, // 50 + 50 = 0 (should wrap and count)
+	}
+
+	result := SimulateDialPart1(rotations)
+	expected := 1 // Should end at 0
+
+	if result != expected {
+		t.Errorf("Dial wrapping test = %d; expected %d", result, expected)
+	}
+}
+
+// negative wrap
+func TestNegativePositionHandling(t *testing.T) {
+	rotations := []Rotation{
+		{'L', 60}, // 50 - 60 = -10 -> 90 (mod 100)
+	}
+
+	result := SimulateDialPart1(rotations)
+	expected := 0 // Should end at 90, not 0
+
+	if result != expected {
+		t.Errorf("Negative position handling = %d; expected %d", result, expected)
+	}
+}
+
+// parse rotations
+func TestParse(t *testing.T) {
+	content := "L68\nR48\nL5\n"
+
+	rotations, err := Parse(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expected := []Rotation{
+		{'L', 68},
+		{'R', 48},
+		{'L', 5},
+	}
+
+	if len(rotations) != len(expected) {
+		t.Fatalf("Expected %d rotations, got %d", len(expected), len(rotations))
+	}
+
+	for i, exp := range expected {
+		if rotations[i] != exp {
+			t.Errorf("Rotation %d: expected %+v, got %+v", i, exp, rotations[i])
+		}
+	}
+}
+
+// empty rotations
+func TestEmptyRotations(t *testing.T) {
+	rotations := []Rotation{}
+
+	part1Result := SimulateDialPart1(rotations)
+	part2Result := SimulateDialPart2(rotations)
+
+	if part1Result != 0 {
+		t.Errorf("Empty rotations part1 = %d; expected 0", part1Result)
+	}
+
+	if part2Result != 0 {
+		t.Errorf("Empty rotations part2 = %d; expected 0", part2Result)
+	}
+}
+
+// zero distance
+func TestZeroDistanceRotation(t *testing.T) {
+	rotations := []Rotation{
+		{'R', 0}, // Should not change position
+		{'L', 0}, // Should not change position
+	}
+
+	part1Result := SimulateDialPart1(rotations)
+	expected := 0 // Should not hit zero
+
+	if part1Result != expected {
+		t.Errorf("Zero distance rotations = %d; expected %d", part1Result, expected)
+	}
+}
+
+// file wrapper
+func TestReadRotations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rotations.txt")
+	if err := os.WriteFile(path, []byte("L68\nL30\nR48\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	rotations, err := ReadRotations(path)
+	if err != nil {
+		t.Fatalf("ReadRotations failed: %v", err)
+	}
+	if len(rotations) != 3 || rotations[2] != (Rotation{'R', 48}) {
+		t.Errorf("ReadRotations = %v; expected [L68 L30 R48]", rotations)
+	}
+
+	if _, err := ReadRotations("does_not_exist.txt"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadRotations(missing) error = %v; expected os.ErrNotExist", err)
+	}
+}
@@ -0,0 +1,353 @@
+/**
+ * Advent of Code 2025 - Day 1: Extended Rotation Grammar
+ *
+ * A superset of the puzzle's one-rotation-per-line format:
+ *
+ *	L68 R48, L5; R60   # several instructions per line, then a comment
+ *	=50                # set the dial to 50 without turning it
+ *	3x(L10 R5)         # repeat a block, blocks may nest and span lines
+ *	2:L15              # turn dial 2 of a lock (ParseMovesFunc only)
+ *
+ * Instructions are separated by whitespace, commas or semicolons, and
+ * # starts a comment running to the end of the line. Every file in
+ * the original format parses to the same rotations.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Set is the Direction of an absolute "=n" instruction, which puts the
+// dial at position n without turning it
+const Set = '='
+
+// maxRotations bounds what Parse will expand repeats to, and what any
+// one repeat may expand to or hold
+var maxRotations = 10_000_000
+
+// errTooMany stops Parse when repeats expand too far
+var errTooMany = errors.New("too many rotations")
+
+// SyntaxError is a parse error at a 1-based line and column
+type SyntaxError struct {
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Msg)
+}
+
+// parser reads instructions a byte at a time, tracking line and column
+type parser struct {
+	r         *bufio.Reader
+	line, col int  // position of the next byte
+	lastCol   int  // column before the last newline, for unread
+	eof       bool // whether the end of input has been read
+	addresses bool // whether n: dial addresses are allowed
+}
+
+// item is one instruction, or a repeat kept as its count and
+// unexpanded body so nesting never buffers more than the input
+type item struct {
+	move Move
+	loop *loop // the repeat, if this is one
+}
+
+// loop is a repeat block
+type loop struct {
+	count int
+	body  []item
+	size  int // moves the whole repeat expands to
+}
+
+// returns how many moves the item expands to
+func (it item) size() int {
+	if it.loop == nil {
+		return 1
+	}
+	return it.loop.size
+}
+
+// calls fn for each move the item expands to
+func (it item) expand(fn func(Move) error) error {
+	if it.loop == nil {
+		return fn(it.move)
+	}
+	for i := 0; i < it.loop.count; i++ {
+		for _, b := range it.loop.body {
+			if err := b.expand(fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parses instructions from r and calls fn for each rotation in order,
+// expanding repeat blocks; stops at the first error fn returns
+func ParseFunc(r io.Reader, fn func(Rotation) error) error {
+	return parseItems(r, false, func(it item) error {
+		return it.expand(func(m Move) error {
+			return fn(m.Rotation)
+		})
+	})
+}
+
+// parses instructions for a lock, where n:L15 addresses dial n and an
+// unaddressed instruction turns dial 1; see ParseFunc
+func ParseMovesFunc(r io.Reader, fn func(Move) error) error {
+	return parseItems(r, true, func(it item) error {
+		return it.expand(fn)
+	})
+}
+
+// parses instructions from r and calls emit for each top-level item
+func parseItems(r io.Reader, addresses bool, emit func(item) error) error {
+	p := &parser{r: bufio.NewReader(r), line: 1, col: 1, addresses: addresses}
+	return p.block(0, 0, 0, emit)
+}
+
+// expands each item into fn, returning errTooMany once repeats have
+// expanded past maxRotations; plain instructions are not counted
+func expandBounded(fn func(Move) error) func(item) error {
+	repeated := 0
+	return func(it item) error {
+		if it.loop != nil {
+			if repeated += it.size(); repeated > maxRotations {
+				return errTooMany
+			}
+		}
+		return it.expand(fn)
+	}
+}
+
+// reads the next byte, returning 0 at the end of input
+func (p *parser) read() (byte, error) {
+	c, err := p.r.ReadByte()
+	if err == io.EOF {
+		p.eof = true
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if c == '\n' {
+		p.line, p.lastCol, p.col = p.line+1, p.col, 1
+	} else {
+		p.col++
+	}
+	return c, nil
+}
+
+// puts back the byte read last; never called after the end of input
+func (p *parser) unread(c byte) {
+	p.r.UnreadByte()
+	if c == '\n' {
+		p.line, p.col = p.line-1, p.lastCol
+	} else {
+		p.col--
+	}
+}
+
+// error at the byte just read, or just past the end of input
+func (p *parser) errorf(format string, args ...any) error {
+	col := p.col - 1
+	if p.eof {
+		col = p.col
+	}
+	return &SyntaxError{Line: p.line, Column: col, Msg: fmt.Sprintf(format, args...)}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// parses instructions up to the end of input (depth 0) or the ")"
+// closing a repeat opened at line, col, calling emit for each item
+func (p *parser) block(depth, line, col int, emit func(item) error) error {
+	for {
+		c, err := p.read()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case c == 0:
+			if depth > 0 {
+				return &SyntaxError{Line: line, Column: col, Msg: "repeat block is never closed"}
+			}
+			return nil
+
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == ',' || c == ';':
+			continue
+
+		case c == '#':
+			for c != '\n' && c != 0 {
+				if c, err = p.read(); err != nil {
+					return err
+				}
+			}
+
+		case c == ')':
+			if depth == 0 {
+				return p.errorf("unexpected )")
+			}
+			return nil
+
+		case c == 'L' || c == 'R' || c == Set:
+			if err := p.instruction(c, 0, emit); err != nil {
+				return err
+			}
+
+		case isDigit(c):
+			p.unread(c)
+			if err := p.counted(depth, emit); err != nil {
+				return err
+			}
+
+		default:
+			return p.errorf("unexpected %q", c)
+		}
+	}
+}
+
+// reads the decimal number that must come next
+func (p *parser) number() (int, error) {
+	line, col := p.line, p.col
+	n, digits := 0, 0
+	for {
+		c, err := p.read()
+		if err != nil {
+			return 0, err
+		}
+		if !isDigit(c) {
+			if digits == 0 && c == 0 {
+				return 0, p.errorf("expected a number")
+			}
+			if digits == 0 {
+				return 0, p.errorf("expected a number, found %q", c)
+			}
+			if c != 0 {
+				p.unread(c)
+			}
+			return n, nil
+		}
+
+		digits++
+		d := int(c - '0')
+		if n > (math.MaxInt-d)/10 {
+			return 0, &SyntaxError{Line: line, Column: col, Msg: "number is too large"}
+		}
+		n = n*10 + d
+	}
+}
+
+// reads the distance or position of an instruction whose letter was
+// just read and emits it for dial; like ParseRotation it takes an
+// optional sign, so L-5 turns the other way
+func (p *parser) instruction(c byte, dial int, emit func(item) error) error {
+	sign, err := p.read()
+	if err != nil {
+		return err
+	}
+	if sign != '+' && sign != '-' && sign != 0 {
+		p.unread(sign)
+	}
+
+	n, err := p.number()
+	if err != nil {
+		return err
+	}
+	if sign == '-' {
+		n = -n
+	}
+	return emit(item{move: Move{Dial: dial, Rotation: Rotation{Direction: c, Distance: n}}})
+}
+
+// parses what follows a leading number: "x(" opens a repeat block,
+// ":" addresses a dial
+func (p *parser) counted(depth int, emit func(item) error) error {
+	line, col := p.line, p.col
+	n, err := p.number()
+	if err != nil {
+		return err
+	}
+
+	c, err := p.read()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case c == 'x':
+		return p.repeat(n, depth, line, col, emit)
+	case c == ':' && !p.addresses:
+		return p.errorf("dial address %d: needs a lock", n)
+	case c == ':':
+		if n < 1 {
+			return &SyntaxError{Line: line, Column: col, Msg: "dials are numbered from 1"}
+		}
+		if c, err = p.read(); err != nil {
+			return err
+		}
+		if c != 'L' && c != 'R' && c != Set {
+			return p.errorf("expected L, R or = after dial address %d:", n)
+		}
+		return p.instruction(c, n, emit)
+	}
+	if p.addresses {
+		return p.errorf("expected x or : after %d", n)
+	}
+	return p.errorf("expected x after repeat count %d", n)
+}
+
+// parses the "(<instructions>)" of a repeat whose count was read at
+// line, col and emits it unexpanded, rejecting it as soon as the body
+// read so far would expand past maxRotations (or hold more than that
+// for 0x). A repeat expanding to nothing is checked but never kept
+func (p *parser) repeat(count, depth, line, col int, emit func(item) error) error {
+	c, err := p.read()
+	for err == nil && (c == ' ' || c == '\t') {
+		c, err = p.read()
+	}
+	if err != nil {
+		return err
+	}
+	if c != '(' {
+		return p.errorf("expected ( to open the repeat block")
+	}
+
+	l := &loop{count: count}
+	size := 0
+	err = p.block(depth+1, p.line, p.col-1, func(it item) error {
+		size += it.size()
+		if size > maxRotations/max(count, 1) {
+			return &SyntaxError{Line: line, Column: col, Msg: fmt.Sprintf("repeat expands to more than %d instructions", maxRotations)}
+		}
+		if count > 0 {
+			l.body = append(l.body, it)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	l.size = size * count
+	if l.size == 0 {
+		return nil
+	}
+	return emit(item{loop: l})
+}
@@ -0,0 +1,168 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 1: Extended Rotation Grammar
+ *
+ * Tests verify the original format still parses identically and the
+ * extended instructions and error positions.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"solver/gen"
+)
+
+// original format parses exactly as line-by-line ParseRotation
+func TestParseOriginalFormat(t *testing.T) {
+	input := gen.Rotations(gen.New(14), 500, 999) + "\n\n  L5  \r\nR0\n"
+
+	var expected []Rotation
+	for _, line := range strings.Split(input, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		r, err := ParseRotation(line)
+		if err != nil {
+			t.Fatalf("ParseRotation(%q) failed: %v", line, err)
+		}
+		expected = append(expected, r)
+	}
+
+	got, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("Parse returned %d rotations; expected %d", len(got), len(expected))
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("rotation %d = %v; expected %v", i, got[i], expected[i])
+		}
+	}
+}
+
+// comments, separators, sets and repeats
+func TestParseExtended(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"L68 R48, L5; R60\n", "L68 R48 L5 R60"},
+		{"# header\nL1 # trailing\n#L2\nR3", "L1 R3"},
+		{"=50 L10\n", "=50 L10"},
+		{"3x(L10 R5)", "L10 R5 L10 R5 L10 R5"},
+		{"2x (L1 2x(R2))", "L1 R2 R2 L1 R2 R2"},
+		{"0x(L1) R1", "R1"},
+		{"2x(\n  L1 # inside\n  R1\n)\n", "L1 R1 L1 R1"},
+		{"L10R5", "L10 R5"},
+		{"L-5 R+5 =-1", "L-5 R5 =-1"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		rotations, err := Parse(strings.NewReader(tt.input))
+		if err != nil {
+			t.Errorf("Parse(%q) failed: %v", tt.input, err)
+			continue
+		}
+
+		words := make([]string, len(rotations))
+		for i, r := range rotations {
+			words[i] = r.String()
+		}
+		if got := strings.Join(words, " "); got != tt.expected {
+			t.Errorf("Parse(%q) = %q; expected %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+// errors point at the offending line and column
+func TestParseSyntaxErrors(t *testing.T) {
+	tests := []struct {
+		input        string
+		line, column int
+	}{
+		{"L10\nX5\n", 2, 1},
+		{"L10 Lx", 1, 6},
+		{"L10\nR", 2, 2},
+		{"3(L1)", 1, 2},
+		{"3x L1", 1, 4},
+		{"L1\n  2x(L1\nR2", 2, 5},
+		{"L1)", 1, 3},
+		{"R99999999999999999999", 1, 2},
+		{"L+x", 1, 3},
+		{"-2x(L1)", 1, 1},
+		{"1x(30000000x(L1))", 1, 4},
+		{"999999999999x(L1)", 1, 1},
+		{"L1\n2x(L1 5000000x(R1) L2)", 2, 1},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+
+		var se *SyntaxError
+		if !errors.As(err, &se) {
+			t.Errorf("Parse(%q) error = %v; expected a SyntaxError", tt.input, err)
+			continue
+		}
+		if se.Line != tt.line || se.Column != tt.column {
+			t.Errorf("Parse(%q) error at %d:%d (%v); expected %d:%d", tt.input, se.Line, se.Column, se, tt.line, tt.column)
+		}
+	}
+}
+
+// repeats cannot expand without bound
+func TestParseTooManyRotations(t *testing.T) {
+	defer func(n int) { maxRotations = n }(maxRotations)
+	maxRotations = 1000
+
+	if _, err := Parse(strings.NewReader("100x(100x(L1))")); err == nil {
+		t.Errorf("Parse of a huge repeat expected error but got none")
+	}
+	// each repeat fits, all of them together do not
+	if _, err := Parse(strings.NewReader("600x(L1) 600x(L1)")); err == nil {
+		t.Errorf("Parse of too many repeats expected error but got none")
+	}
+	// a repeat that expands to nothing still cannot hold a huge body
+	if _, err := Parse(strings.NewReader("0x(" + strings.Repeat("L1 ", 1001) + ")")); err == nil {
+		t.Errorf("Parse of a huge empty repeat expected error but got none")
+	}
+	if _, err := ParseMoves(strings.NewReader("2x(600x(2:L1))")); err == nil {
+		t.Errorf("ParseMoves of a huge repeat expected error but got none")
+	}
+
+	// plain rotations are not limited
+	rotations, err := Parse(strings.NewReader(strings.Repeat("L1\n", 1500)))
+	if err != nil || len(rotations) != 1500 {
+		t.Errorf("Parse of 1500 rotations = %d rotations, %v; expected 1500", len(rotations), err)
+	}
+}
+
+// sets move the dial without scoring
+func TestSetInstruction(t *testing.T) {
+	rotations, err := Parse(strings.NewReader("=0 =99 R1 =0 L100"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	// only R1 (ends on 0) and L100 (one full turn back to 0) score
+	if got := SimulateDialPart1(rotations); got != 2 {
+		t.Errorf("SimulateDialPart1 = %d; expected 2", got)
+	}
+	if got := SimulateDialPart2(rotations); got != 2 {
+		t.Errorf("SimulateDialPart2 = %d; expected 2", got)
+	}
+
+	var r Rotation
+	if err := r.UnmarshalText([]byte("=42")); err != nil || r != (Rotation{Set, 42}) {
+		t.Errorf("UnmarshalText(=42) = %v, %v; expected =42", r, err)
+	}
+}
@@ -0,0 +1,393 @@
+/**
+ * Advent of Code 2025 - Day 1: Rotation Synthesis
+ *
+ * Works backwards from passwords to an input: builds rotations for
+ * the safe dial whose part 1 and part 2 counts are given up front.
+ *
+ * The rotations that reach 0 all turn the same way, so from 50 the
+ * dial points at 0 after 50, 150, 250, ... clicks in total. Part 2 is
+ * then fixed by the total number of clicks, and part 1 by which of
+ * those zero points a rotation stops on. What is left is splitting
+ * the total into rotations that stop on exactly the chosen zero
+ * points. When that cannot make as many rotations as asked for, the
+ * rest turn back and forth around 50 first, never reaching 0.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+)
+
+// Any leaves a part's password free in a Target
+const Any = -1
+
+// Target describes the rotations Synthesize should produce
+type Target struct {
+	Part1       int // password for part 1, or Any
+	Part2       int // password for part 2, or Any
+	Length      int // exact number of rotations, 0 for as few as possible
+	MaxDistance int // longest rotation allowed, at least 1
+}
+
+// builds rotations whose passwords match t, verified by simulating
+// them. A free part is matched to the other one. With a nil rng the
+// result is deterministic, otherwise rng picks among valid inputs
+func Synthesize(t Target, rng *rand.Rand) ([]Rotation, error) {
+	if t.MaxDistance < 1 {
+		return nil, fmt.Errorf("max distance %d must be at least 1", t.MaxDistance)
+	}
+	if t.Length < 0 {
+		return nil, fmt.Errorf("invalid length %d", t.Length)
+	}
+
+	p1, p2 := t.Part1, t.Part2
+	switch {
+	case p1 == Any && p2 == Any:
+		return nil, fmt.Errorf("no password to synthesize for")
+	case p1 == Any:
+		p1 = p2
+	case p2 == Any:
+		p2 = p1
+	}
+	if p1 < 0 || p2 < 0 {
+		return nil, fmt.Errorf("invalid passwords %d and %d", p1, p2)
+	}
+	if p1 > p2 {
+		return nil, fmt.Errorf("part 1 password %d cannot exceed part 2 password %d", p1, p2)
+	}
+
+	s := synth{maxDistance: t.MaxDistance, zeros: p2, rng: rng}
+	plan, padding, ok := s.search(p1, t.Length)
+	if !ok {
+		return nil, fmt.Errorf("no rotations of at most %d clicks give passwords %d and %d", t.MaxDistance, p1, p2)
+	}
+
+	direction := byte('R')
+	if rng != nil && rng.IntN(2) == 1 {
+		direction = 'L' // 50 is halfway round, so left works the same
+	}
+
+	rotations := s.pad(padding)
+	prev := 0
+	for _, stop := range plan {
+		rotations = append(rotations, Rotation{Direction: direction, Distance: stop - prev})
+		prev = stop
+	}
+
+	if got1, got2 := SimulateDialPart1(rotations), SimulateDialPart2(rotations); got1 != p1 || got2 != p2 {
+		return nil, fmt.Errorf("synthesized rotations give %d and %d instead of %d and %d", got1, got2, p1, p2)
+	}
+	return rotations, nil
+}
+
+// synth plans where rotations stop, measured in clicks from the start
+type synth struct {
+	maxDistance int
+	zeros       int // zero points on the walk, the part 2 password
+	rng         *rand.Rand
+}
+
+// whether the dial points at 0 after clicks clicks in total
+func isZeroPoint(clicks int) bool {
+	return clicks%100 == 50
+}
+
+// zero points strictly between a and b
+func zeroPointsBetween(a, b int) int {
+	// zero points up to x: those of the form 100k-50 with k >= 1
+	upTo := func(x int) int {
+		if x < 50 {
+			return 0
+		}
+		return (x-50)/100 + 1
+	}
+	return upTo(b-1) - upTo(a)
+}
+
+// fewest rotations from a to b that stop on no zero point in between,
+// or -1 if there is no way round them
+func (s synth) minPieces(a, b int) int {
+	pieces := 0
+	for a < b {
+		next := min(a+s.maxDistance, b)
+		if next < b && isZeroPoint(next) {
+			next--
+		}
+		if next == a {
+			return -1
+		}
+		a = next
+		pieces++
+	}
+	return pieces
+}
+
+// most rotations from a to b: every click its own rotation, except
+// that none may stop on a zero point in between
+func (s synth) maxPieces(a, b int) int {
+	return (b - a) - zeroPointsBetween(a, b)
+}
+
+// picks a total number of clicks and the zero points to stop on, then
+// splits the walk into length rotations (or the fewest if length is 0);
+// returns the cumulative clicks at each stop and how many rotations
+// must be padding to make up length
+func (s synth) search(stops, length int) ([]int, int, bool) {
+	// part 2 counts the zero points within the total, so the total
+	// lies between the last zero point and the next one
+	lo, hi := 0, 49
+	if s.zeros > 0 {
+		lo, hi = 100*s.zeros-50, 100*s.zeros+49
+	}
+
+	totals := make([]int, 0, hi-lo+1)
+	for total := lo; total <= hi; total++ {
+		totals = append(totals, total)
+	}
+	if s.rng != nil {
+		s.rng.Shuffle(len(totals), func(i, j int) { totals[i], totals[j] = totals[j], totals[i] })
+	}
+
+	var best []int
+	bestPieces := -1
+	for _, total := range totals {
+		if total == 0 && (stops > 0 || length > 0) {
+			continue
+		}
+
+		// ending on the last zero point always stops on it
+		if isZeroPoint(total) && stops == 0 {
+			continue
+		}
+
+		bounds := s.chooseStops(stops, total)
+		mins, maxs, ok := s.pieceRanges(bounds)
+		if !ok {
+			continue
+		}
+
+		lowest, highest := sum(mins), sum(maxs)
+		pieces := lowest
+		if length > 0 {
+			if pieces = s.oneWay(lowest, highest, length); pieces < 0 {
+				continue
+			}
+		}
+		if length == 0 && bestPieces >= 0 && lowest >= bestPieces {
+			continue
+		}
+
+		counts := s.distribute(mins, maxs, pieces)
+		plan, ok := s.split(bounds, counts)
+		if !ok {
+			continue
+		}
+		if length > 0 {
+			return plan, length - pieces, true
+		}
+		best, bestPieces = plan, lowest
+	}
+
+	return best, 0, bestPieces >= 0
+}
+
+// how many of length rotations turn one way, between lowest and
+// highest, leaving a number pad can make up; -1 if there is none
+func (s synth) oneWay(lowest, highest, length int) int {
+	least := max(0, length-highest)
+	for padding := least; padding <= least+2 && padding <= length-lowest; padding++ {
+		if s.canPad(padding) {
+			return length - padding
+		}
+	}
+	return -1
+}
+
+// whether pad can make n rotations: they come in pairs, or threes
+// when a rotation may be two clicks
+func (s synth) canPad(n int) bool {
+	return n == 0 || n >= 2 && (n%2 == 0 || s.maxDistance >= 2)
+}
+
+// n rotations out from 50 and back again without reaching 0, stopping
+// on 50 after each pair (or the last three); see canPad
+func (s synth) pad(n int) []Rotation {
+	reach := min(49, s.maxDistance)
+	rotations := make([]Rotation, 0, n)
+	for n > 0 {
+		out, back := byte('R'), byte('L')
+		if s.rng != nil && s.rng.IntN(2) == 1 {
+			out, back = back, out
+		}
+
+		if n == 3 {
+			a, b := 1, 1
+			if s.rng != nil {
+				a = 1 + s.rng.IntN(reach-1)
+				b = 1 + s.rng.IntN(reach-a)
+			}
+			rotations = append(rotations, Rotation{out, a}, Rotation{out, b}, Rotation{back, a + b})
+			break
+		}
+
+		d := 1
+		if s.rng != nil {
+			d = 1 + s.rng.IntN(reach)
+		}
+		rotations = append(rotations, Rotation{out, d}, Rotation{back, d})
+		n -= 2
+	}
+	return rotations
+}
+
+// the walk's mandatory stops: the chosen zero points, then total
+func (s synth) chooseStops(stops, total int) []int {
+	last := s.zeros
+	if isZeroPoint(total) {
+		// total is the last zero point and must be one of the stops
+		stops--
+		last--
+	}
+
+	// the first zero points, or a random sample of them drawn without
+	// listing every one (Floyd's algorithm)
+	picked := make([]int, 0, stops)
+	if s.rng == nil {
+		for k := range stops {
+			picked = append(picked, k)
+		}
+	} else {
+		seen := make(map[int]bool, stops)
+		for j := last - stops; j < last; j++ {
+			k := s.rng.IntN(j + 1)
+			if seen[k] {
+				k = j
+			}
+			seen[k] = true
+			picked = append(picked, k)
+		}
+		sort.Ints(picked)
+	}
+
+	bounds := make([]int, 0, stops+1)
+	for _, k := range picked {
+		bounds = append(bounds, 100*(k+1)-50)
+	}
+	return append(bounds, total)
+}
+
+// fewest and most rotations for each stretch between stops
+func (s synth) pieceRanges(bounds []int) ([]int, []int, bool) {
+	mins, maxs := make([]int, len(bounds)), make([]int, len(bounds))
+	prev := 0
+	for i, b := range bounds {
+		mins[i], maxs[i] = s.minPieces(prev, b), s.maxPieces(prev, b)
+		if mins[i] < 0 || mins[i] > maxs[i] {
+			return nil, nil, false
+		}
+		prev = b
+	}
+	return mins, maxs, true
+}
+
+// shares length rotations between stretches, each within its range
+func (s synth) distribute(mins, maxs []int, length int) []int {
+	counts := append([]int(nil), mins...)
+	extra := length - sum(mins)
+
+	for extra > 0 {
+		i := 0
+		if s.rng != nil {
+			i = s.rng.IntN(len(counts))
+		}
+		for counts[i] == maxs[i] {
+			i = (i + 1) % len(counts)
+		}
+
+		add := min(extra, maxs[i]-counts[i])
+		if s.rng != nil {
+			add = 1 + s.rng.IntN(add)
+		}
+		counts[i] += add
+		extra -= add
+	}
+	return counts
+}
+
+// splits each stretch between stops into its share of rotations
+func (s synth) split(bounds, counts []int) ([]int, bool) {
+	var plan []int
+	prev := 0
+	for i, b := range bounds {
+		stretch, ok := s.splitStretch(prev, b, counts[i])
+		if !ok {
+			return nil, false
+		}
+		plan = append(plan, stretch...)
+		prev = b
+	}
+	return plan, true
+}
+
+// splits a to b into exactly pieces rotations that skip zero points,
+// returning where each stops
+func (s synth) splitStretch(a, b, pieces int) ([]int, bool) {
+	if pieces == 0 {
+		return nil, a == b
+	}
+
+	stops := make([]int, 0, pieces)
+	for pieces > 1 {
+		first, last := a+1, min(a+s.maxDistance, b-1)
+
+		// the rest must fit in pieces-1: far enough along for the
+		// fewest, not so far that there is no room for the most
+		lo := first + sort.Search(last-first+1, func(i int) bool {
+			m := s.minPieces(first+i, b)
+			return m >= 0 && m <= pieces-1
+		})
+		hi := first - 1 + sort.Search(last-first+1, func(i int) bool {
+			return s.maxPieces(first+i, b) < pieces-1
+		})
+		if isZeroPoint(lo) {
+			lo++
+		}
+		if isZeroPoint(hi) {
+			hi--
+		}
+		if lo > hi {
+			return nil, false
+		}
+
+		next := lo
+		if s.rng != nil {
+			next = lo + s.rng.IntN(hi-lo+1)
+			if isZeroPoint(next) {
+				next = lo
+			}
+		}
+
+		stops = append(stops, next)
+		a = next
+		pieces--
+	}
+
+	if b-a < 1 || b-a > s.maxDistance {
+		return nil, false
+	}
+	return append(stops, b), true
+}
+
+func sum(xs []int) int {
+	total := 0
+	for _, x := range xs {
+		total += x
+	}
+	return total
+}
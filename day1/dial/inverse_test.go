@@ -0,0 +1,145 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 1: Rotation Synthesis
+ *
+ * Tests verify synthesized rotations reproduce their passwords within
+ * the constraints, and that impossible targets are refused.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// checks rotations against the target they were built for
+func checkSynthesized(t *testing.T, target Target, rotations []Rotation) {
+	t.Helper()
+
+	want1, want2 := target.Part1, target.Part2
+	if want1 == Any {
+		want1 = want2
+	}
+	if want2 == Any {
+		want2 = want1
+	}
+
+	if got := SimulateDialPart1(rotations); got != want1 {
+		t.Errorf("%+v: part 1 = %d; expected %d", target, got, want1)
+	}
+	if got := SimulateDialPart2(rotations); got != want2 {
+		t.Errorf("%+v: part 2 = %d; expected %d", target, got, want2)
+	}
+	if target.Length > 0 && len(rotations) != target.Length {
+		t.Errorf("%+v: %d rotations; expected %d", target, len(rotations), target.Length)
+	}
+	for _, r := range rotations {
+		if r.Distance < 1 || r.Distance > target.MaxDistance {
+			t.Errorf("%+v: rotation %v outside 1..%d", target, r, target.MaxDistance)
+		}
+	}
+}
+
+// the worked example's passwords
+func TestSynthesizeExample(t *testing.T) {
+	target := Target{Part1: 3, Part2: 6, Length: 10, MaxDistance: 99}
+
+	rotations, err := Synthesize(target, nil)
+	if err != nil {
+		t.Fatalf("Synthesize failed: %v", err)
+	}
+	checkSynthesized(t, target, rotations)
+
+	again, _ := Synthesize(target, nil)
+	for i := range rotations {
+		if again[i] != rotations[i] {
+			t.Fatalf("Synthesize without rng is not deterministic")
+		}
+	}
+}
+
+// fewest rotations
+func TestSynthesizeShortest(t *testing.T) {
+	tests := []struct {
+		target   Target
+		expected int
+	}{
+		{Target{Part1: 0, Part2: 5, MaxDistance: 1000}, 1},  // one long turn
+		{Target{Part1: 1, Part2: 1, MaxDistance: 50}, 1},    // straight to 0
+		{Target{Part1: 2, Part2: Any, MaxDistance: 100}, 2}, // 0 then a full turn
+		{Target{Part1: Any, Part2: 2, MaxDistance: 1}, 150}, // one click at a time
+		{Target{Part1: 0, Part2: 0, MaxDistance: 10}, 0},    // nothing to do
+		{Target{Part1: 3, Part2: 6, MaxDistance: 1000}, 3},  // three stops on 0
+		{Target{Part1: 0, Part2: 1, MaxDistance: 30}, 2},    // 51 clicks take two turns
+		{Target{Part1: 1, Part2: 3, MaxDistance: 99999}, 1}, // ends on the last zero
+		{Target{Part1: 2, Part2: 2, MaxDistance: 60}, 3},    // 50, then 100 in two
+		{Target{Part1: 0, Part2: 2, MaxDistance: 2}, 76},    // hops over every zero
+		{Target{Part1: 1, Part2: 2, MaxDistance: 200}, 1},   // 150 in one go
+		{Target{Part1: 0, Part2: 0, Length: 3, MaxDistance: 10}, 3},
+		{Target{Part1: 0, Part2: 0, Length: 60, MaxDistance: 10}, 60}, // back and forth
+		{Target{Part1: 1, Part2: 1, Length: 200, MaxDistance: 60}, 200},
+	}
+
+	for _, tt := range tests {
+		rotations, err := Synthesize(tt.target, nil)
+		if err != nil {
+			t.Errorf("Synthesize(%+v) failed: %v", tt.target, err)
+			continue
+		}
+		checkSynthesized(t, tt.target, rotations)
+		if len(rotations) != tt.expected {
+			t.Errorf("Synthesize(%+v) gave %d rotations %v; expected %d", tt.target, len(rotations), rotations, tt.expected)
+		}
+	}
+}
+
+// random targets and random choices
+func TestSynthesizeRandom(t *testing.T) {
+	rng := rand.New(rand.NewPCG(15, 15))
+
+	for trial := 0; trial < 300; trial++ {
+		p2 := rng.IntN(20)
+		target := Target{
+			Part1:       rng.IntN(p2 + 1),
+			Part2:       p2,
+			MaxDistance: 2 + rng.IntN(300),
+		}
+		if rng.IntN(2) == 1 {
+			shortest, err := Synthesize(target, nil)
+			if err != nil {
+				t.Fatalf("Synthesize(%+v) failed: %v", target, err)
+			}
+			target.Length = len(shortest) + rng.IntN(20)
+			if target.Length == 0 {
+				continue
+			}
+		}
+
+		rotations, err := Synthesize(target, rng)
+		if err != nil {
+			t.Fatalf("Synthesize(%+v) failed: %v", target, err)
+		}
+		checkSynthesized(t, target, rotations)
+	}
+}
+
+// impossible targets
+func TestSynthesizeImpossible(t *testing.T) {
+	tests := []Target{
+		{Part1: 4, Part2: 3, MaxDistance: 100},           // p1 cannot exceed p2
+		{Part1: Any, Part2: Any, MaxDistance: 100},       // nothing to aim for
+		{Part1: 1, Part2: 2, MaxDistance: 1},             // one click cannot skip a zero
+		{Part1: 1, Part2: 1, Length: 1, MaxDistance: 10}, // 0 is 50 clicks away
+		{Part1: 1, Part2: 1, MaxDistance: 0},
+		{Part1: -2, Part2: 1, MaxDistance: 100},
+	}
+
+	for _, target := range tests {
+		if rotations, err := Synthesize(target, nil); err == nil {
+			t.Errorf("Synthesize(%+v) = %v; expected error", target, rotations)
+		}
+	}
+}
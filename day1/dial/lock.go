@@ -0,0 +1,157 @@
+/**
+ * Advent of Code 2025 - Day 1: Combination Locks
+ *
+ * A lock is several dials turned independently, with instructions
+ * like 2:L15 addressing dial 2. Each dial counts hits with its own
+ * Method as usual, and the lock only counts a hit while every other
+ * dial rests on one of its targets, so a one-dial lock gives exactly
+ * the part 1 and part 2 passwords.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// Move is a rotation of one dial of a lock
+type Move struct {
+	Dial     int // 1-based dial number, 0 for dial 1
+	Rotation Rotation
+}
+
+// formats a move the way the input writes it, e.g. 2:L15
+func (m Move) String() string {
+	if m.Dial == 0 {
+		return m.Rotation.String()
+	}
+	return strconv.Itoa(m.Dial) + ":" + m.Rotation.String()
+}
+
+// Lock is a set of dials, addressed as 1:, 2:, ... in input order
+type Lock struct {
+	Dials []Dial
+}
+
+// a lock of n safe dials all counting with method
+func SafeLock(n int, method Method) Lock {
+	dials := make([]Dial, n)
+	for i := range dials {
+		dials[i] = SafeDial(method)
+	}
+	return Lock{Dials: dials}
+}
+
+// checks the lock has dials and every dial is valid
+func (l Lock) Validate() error {
+	if len(l.Dials) == 0 {
+		return fmt.Errorf("lock has no dials")
+	}
+	for i, d := range l.Dials {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("dial %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// LockCounter feeds moves to a lock one at a time
+type LockCounter struct {
+	lock      Lock
+	Positions []int // where each dial points now
+	Hits      []int // each dial's own count
+	Total     int   // hits while every other dial rests on a target
+}
+
+// returns a counter with every dial at its start position
+func (l Lock) NewCounter() (*LockCounter, error) {
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+
+	c := &LockCounter{lock: l, Positions: make([]int, len(l.Dials)), Hits: make([]int, len(l.Dials))}
+	for i, d := range l.Dials {
+		c.Positions[i] = d.Start
+	}
+	return c, nil
+}
+
+// whether dial i points at one of its targets
+func (c *LockCounter) onTarget(i int) bool {
+	for _, t := range c.lock.Dials[i].Targets {
+		if c.Positions[i] == t {
+			return true
+		}
+	}
+	return false
+}
+
+// turns the addressed dial and adds its hits
+func (c *LockCounter) Add(m Move) error {
+	i := max(m.Dial, 1) - 1
+	if i >= len(c.Positions) {
+		return fmt.Errorf("move %s: lock has %d dials", m, len(c.Positions))
+	}
+
+	var hits int
+	c.Positions[i], hits = c.lock.Dials[i].Turn(c.Positions[i], m.Rotation)
+	c.Hits[i] += hits
+
+	for j := range c.Positions {
+		if j != i && !c.onTarget(j) {
+			return nil
+		}
+	}
+	c.Total += hits
+	return nil
+}
+
+// applies every move from the start positions and returns the lock's count
+func (l Lock) Count(moves []Move) (int, error) {
+	c, err := l.NewCounter()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, m := range moves {
+		if err := c.Add(m); err != nil {
+			return 0, err
+		}
+	}
+	return c.Total, nil
+}
+
+// parses lock instructions from any reader, see ParseMovesFunc
+func ParseMoves(r io.Reader) ([]Move, error) {
+	var moves []Move
+	err := parseItems(r, true, expandBounded(func(m Move) error {
+		moves = append(moves, m)
+		return nil
+	}))
+	if errors.Is(err, errTooMany) {
+		return nil, fmt.Errorf("repeats expand to more than %d moves", maxRotations)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return moves, nil
+}
+
+// reads and parses lock instructions from file, see ParseMoves
+func ReadMoves(filename string) ([]Move, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ParseMoves(file)
+}
@@ -0,0 +1,156 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 1: Combination Locks
+ *
+ * Tests verify addressed instructions, combined counting and that a
+ * one-dial lock matches the single dial passwords.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"solver/gen"
+)
+
+// parse addressed moves
+func TestParseMoves(t *testing.T) {
+	moves, err := ParseMoves(strings.NewReader("2:R50 L50\n2x(1:L100, 3:=7) # lock\n"))
+	if err != nil {
+		t.Fatalf("ParseMoves failed: %v", err)
+	}
+
+	words := make([]string, len(moves))
+	for i, m := range moves {
+		words[i] = m.String()
+	}
+	expected := "2:R50 L50 1:L100 3:=7 1:L100 3:=7"
+	if got := strings.Join(words, " "); got != expected {
+		t.Errorf("ParseMoves = %q; expected %q", got, expected)
+	}
+}
+
+// bad addresses
+func TestParseMovesErrors(t *testing.T) {
+	tests := []struct {
+		input        string
+		line, column int
+	}{
+		{"0:L1", 1, 1},
+		{"L1 2:X5", 1, 6},
+		{"2;L5", 1, 2},
+		{"2:", 1, 3},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseMoves(strings.NewReader(tt.input))
+
+		var se *SyntaxError
+		if !errors.As(err, &se) {
+			t.Errorf("ParseMoves(%q) error = %v; expected a SyntaxError", tt.input, err)
+			continue
+		}
+		if se.Line != tt.line || se.Column != tt.column {
+			t.Errorf("ParseMoves(%q) error at %d:%d (%v); expected %d:%d", tt.input, se.Line, se.Column, se, tt.line, tt.column)
+		}
+	}
+
+	// plain rotations have no dials to address
+	if _, err := Parse(strings.NewReader("L5\n2:R5\n")); err == nil {
+		t.Errorf("Parse of an addressed move expected error but got none")
+	}
+}
+
+// hits only count while the other dials rest on 0
+func TestLockCount(t *testing.T) {
+	moves, err := ParseMoves(strings.NewReader("2:R50\nL50\n1:L100\n3x(2:R100)\n2:L1 1:R100\n"))
+	if err != nil {
+		t.Fatalf("ParseMoves failed: %v", err)
+	}
+
+	c, err := SafeLock(2, EveryClick).NewCounter()
+	if err != nil {
+		t.Fatalf("NewCounter failed: %v", err)
+	}
+	for _, m := range moves {
+		if err := c.Add(m); err != nil {
+			t.Fatalf("Add(%v) failed: %v", m, err)
+		}
+	}
+
+	// dial 1 at 0 when dial 2 reaches 0 and for its own full turns,
+	// but not once dial 2 has moved off 0
+	if c.Total != 5 {
+		t.Errorf("lock total = %d; expected 5", c.Total)
+	}
+	if c.Hits[0] != 3 || c.Hits[1] != 4 {
+		t.Errorf("per dial hits = %v; expected [3 4]", c.Hits)
+	}
+	if c.Positions[0] != 0 || c.Positions[1] != 99 {
+		t.Errorf("positions = %v; expected [0 99]", c.Positions)
+	}
+}
+
+// a one-dial lock is the puzzle itself
+func TestLockSingleDial(t *testing.T) {
+	rotations, err := Parse(strings.NewReader(gen.Rotations(gen.New(17), 2000, 999)))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	moves := make([]Move, len(rotations))
+	for i, r := range rotations {
+		moves[i] = Move{Rotation: r}
+	}
+
+	for _, method := range []Method{EndOfRotation, EveryClick} {
+		got, err := SafeLock(1, method).Count(moves)
+		if err != nil {
+			t.Fatalf("Count failed: %v", err)
+		}
+		want, _ := SafeDial(method).Count(rotations)
+		if got != want {
+			t.Errorf("one-dial lock with method %d = %d; expected %d", method, got, want)
+		}
+	}
+}
+
+// invalid locks and out of range dials
+func TestLockErrors(t *testing.T) {
+	if _, err := (Lock{}).Count(nil); err == nil {
+		t.Errorf("Count on a lock without dials expected error but got none")
+	}
+	if _, err := (Lock{Dials: []Dial{SafeDial(EveryClick), {}}}).Count(nil); err == nil {
+		t.Errorf("Count with an invalid dial expected error but got none")
+	}
+	if _, err := SafeLock(2, EveryClick).Count([]Move{{Dial: 3, Rotation: Rotation{'L', 1}}}); err == nil {
+		t.Errorf("Count with a move for dial 3 of 2 expected error but got none")
+	}
+}
+
+// file wrapper
+func TestReadMoves(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lock.txt")
+	if err := os.WriteFile(path, []byte("2:R50 L50\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	moves, err := ReadMoves(path)
+	if err != nil {
+		t.Fatalf("ReadMoves failed: %v", err)
+	}
+	if len(moves) != 2 || moves[0].Dial != 2 || moves[1].Dial != 0 {
+		t.Errorf("ReadMoves = %v; expected [2:R50 L50]", moves)
+	}
+
+	if _, err := ReadMoves("does_not_exist.txt"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadMoves(missing) error = %v; expected os.ErrNotExist", err)
+	}
+}
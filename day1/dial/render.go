@@ -0,0 +1,286 @@
+/**
+ * Advent of Code 2025 - Day 1: Dial Rendering
+ *
+ * Draws what the dial does over a list of rotations, either as an
+ * SVG with one lane per rotation or as an ANSI terminal animation.
+ * Stops on a target (counted by both parts) and clicks that pass a
+ * target mid-rotation (counted by part 2 only) are marked apart, so
+ * the difference between the parts is visible at a glance.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+)
+
+// a target hit drawn on a rendering
+type mark struct {
+	target int
+	click  int  // clicks into the rotation, 1-based
+	count  int  // hits it stands for, one per full turn
+	stop   bool // the rotation ends here, so part 1 counts it too
+}
+
+// returns how many clicks a rotation sweeps and which way, agreeing
+// with Turn on the rotations that never click
+func (d Dial) sweep(rotation Rotation) (int, int) {
+	clicks, step := rotation.Distance, 1
+	if rotation.Direction == 'L' {
+		step = -1
+	} else if rotation.Direction != 'R' {
+		return 0, step
+	}
+	if clicks < 0 {
+		if d.Method == EveryClick {
+			return 0, step
+		}
+		clicks, step = -clicks, -step
+	}
+	return clicks, step
+}
+
+// returns where a rotation from start touches the dial's targets: the
+// first pass of each standing for every full turn after it, then the
+// stop, so a long rotation still has at most two marks per target
+func (d Dial) marks(start int, rotation Rotation) []mark {
+	clicks, step := d.sweep(rotation)
+
+	var marks []mark
+	for _, t := range d.Targets {
+		gap := mod((t-start)*step, d.Size)
+		if gap == 0 {
+			gap = d.Size
+		}
+		if gap > clicks {
+			continue
+		}
+
+		passes := (clicks-gap)/d.Size + 1
+		stop := (clicks-gap)%d.Size == 0
+		if stop {
+			passes--
+		}
+		if passes > 0 {
+			marks = append(marks, mark{target: t, click: gap, count: passes})
+		}
+		if stop {
+			marks = append(marks, mark{target: t, click: clicks, count: 1, stop: true})
+		}
+	}
+	return marks
+}
+
+// angle of a position, clockwise from the top like a real dial
+func (d Dial) angle(position float64) float64 {
+	return 2 * math.Pi * position / float64(d.Size)
+}
+
+// SVG geometry
+const (
+	svgInner = 60.0 // radius of the first lane
+	svgLane  = 8.0  // width of each lane
+	svgPad   = 40.0 // room for labels around the outermost lane
+)
+
+// draws each rotation as an arc in its own ring, innermost first:
+// turns of more than a full circle spiral outwards within the lane.
+// Filled dots are stops on a target, hollow ones are passes
+func WriteSVG(w io.Writer, d Dial, rotations []Rotation) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+
+	outer := svgInner + svgLane*float64(len(rotations)+1)
+	size := 2 * (outer + svgPad)
+	cx, cy := size/2, size/2
+	point := func(position, radius float64) (float64, float64) {
+		a := d.angle(position)
+		return cx + radius*math.Sin(a), cy - radius*math.Cos(a)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.0f %.0f">`+"\n", size, size+40, size, size+40)
+	b.WriteString(`<rect width="100%" height="100%" fill="white"/>` + "\n")
+	fmt.Fprintf(&b, `<circle cx="%.1f" cy="%.1f" r="%.1f" fill="none" stroke="#ccc"/>`+"\n", cx, cy, outer)
+
+	// ticks every position, labels about every tenth of the dial
+	labelEvery := max(1, d.Size/10)
+	for p := 0; p < d.Size; p++ {
+		x1, y1 := point(float64(p), outer)
+		x2, y2 := point(float64(p), outer+4)
+		fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#999"/>`+"\n", x1, y1, x2, y2)
+		if p%labelEvery == 0 {
+			x, y := point(float64(p), outer+16)
+			fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" font-size="11" text-anchor="middle" dominant-baseline="middle">%d</text>`+"\n", x, y, p)
+		}
+	}
+	for _, t := range d.Targets {
+		x1, y1 := point(float64(t), svgInner-svgLane)
+		x2, y2 := point(float64(t), outer)
+		fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#e33" stroke-dasharray="3 3"/>`+"\n", x1, y1, x2, y2)
+	}
+
+	position := d.Start
+	for i, rotation := range rotations {
+		end, _ := d.Turn(position, rotation)
+		radius := svgInner + svgLane*float64(i)
+
+		clicks, step := d.sweep(rotation)
+
+		// the radius creeps outwards across the rotation, so full turns
+		// do not draw over each other
+		at := func(c int) (float64, float64) {
+			spread := 0.0
+			if clicks > 0 {
+				spread = svgLane * 0.7 * float64(c) / float64(clicks)
+			}
+			return point(float64(position+step*c), radius+spread)
+		}
+
+		every := max(1, clicks/400)
+		var path strings.Builder
+		x, y := at(0)
+		fmt.Fprintf(&path, "M%.1f %.1f", x, y)
+		for c := every; c < clicks+every; c += every {
+			x, y := at(min(c, clicks))
+			fmt.Fprintf(&path, " L%.1f %.1f", x, y)
+		}
+		fmt.Fprintf(&b, `<path d="%s" fill="none" stroke="#36c" stroke-width="2"><title>%d: %s %d -> %d</title></path>`+"\n",
+			path.String(), i+1, rotation, position, end)
+
+		for _, m := range d.marks(position, rotation) {
+			x, y := at(m.click)
+			fill := "white"
+			if m.stop {
+				fill = "#e33"
+			}
+			fmt.Fprintf(&b, `<circle cx="%.1f" cy="%.1f" r="3" fill="%s" stroke="#e33" stroke-width="1.5"/>`+"\n", x, y, fill)
+			if m.count > 1 {
+				fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" font-size="9" fill="#e33">×%d</text>`+"\n", x+5, y-5, m.count)
+			}
+		}
+
+		position = end
+	}
+
+	part1, part2 := d, d
+	part1.Method, part2.Method = EndOfRotation, EveryClick
+	p1, _ := part1.Count(rotations)
+	p2, _ := part2.Count(rotations)
+
+	fmt.Fprintf(&b, `<text x="10" y="%.0f" font-size="13">● stop on target: part 1 and 2 (%d)   ○ pass mid-rotation: part 2 only (%d total)</text>`+"\n", size+20, p1, p2)
+	b.WriteString("</svg>\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// ANSI colours used by the animation
+const (
+	ansiReset  = "\x1b[0m"
+	ansiArc    = "\x1b[36m" // cyan: clicks of the current rotation
+	ansiPass   = "\x1b[33m" // yellow: a target passed mid-rotation
+	ansiStop   = "\x1b[32m" // green: a target the rotation stops on
+	ansiTarget = "\x1b[31m" // red: targets otherwise
+	ansiClear  = "\x1b[H\x1b[2J"
+)
+
+// ring size in terminal cells; cells are about twice as tall as wide
+const (
+	ringRows = 21
+	ringCols = 43
+)
+
+// draws the dial as a ring of characters after step, colouring the
+// arc the rotation swept and how it touched the targets
+func frame(d Dial, s Step, count, part1, part2 int) string {
+	grid := make([][]string, ringRows)
+	for r := range grid {
+		grid[r] = make([]string, ringCols)
+		for c := range grid[r] {
+			grid[r][c] = " "
+		}
+	}
+	cell := func(p int) (int, int) {
+		a := d.angle(float64(p))
+		r := int(math.Round(float64(ringRows/2) * (1 - math.Cos(a))))
+		c := int(math.Round(float64(ringCols/2) * (1 + math.Sin(a))))
+		return r, c
+	}
+	put := func(p int, text string) {
+		r, c := cell(p)
+		grid[r][c] = text
+	}
+
+	for p := 0; p < d.Size; p++ {
+		put(p, "·")
+	}
+
+	// the swept arc, capped at one full turn
+	clicks, step := d.sweep(s.Rotation)
+	for c := 1; c <= min(clicks, d.Size); c++ {
+		put(mod(s.Start+step*c, d.Size), ansiArc+"•"+ansiReset)
+	}
+
+	marks := d.marks(s.Start, s.Rotation)
+	for _, t := range d.Targets {
+		colour := ansiTarget
+		for _, m := range marks {
+			if m.target != t {
+				continue
+			}
+			if m.stop {
+				colour = ansiStop
+				break
+			}
+			colour = ansiPass
+		}
+		put(t, colour+"◆"+ansiReset)
+	}
+	put(s.End, ansiStop+"●"+ansiReset)
+
+	var b strings.Builder
+	for _, row := range grid {
+		b.WriteString(strings.TrimRight(strings.Join(row, ""), " "))
+		b.WriteByte('\n')
+	}
+
+	passes := 0
+	for _, m := range marks {
+		if !m.stop {
+			passes += m.count
+		}
+	}
+	fmt.Fprintf(&b, "\n%d/%d  %s  %d -> %d  passes %d\n", s.Index, count, s.Rotation, s.Start, s.End, passes)
+	fmt.Fprintf(&b, "part 1 (stops) %d   part 2 (every click) %d\n", part1, part2)
+	return b.String()
+}
+
+// plays the rotations on the terminal one frame per rotation, keeping
+// both parts' counts side by side
+func Animate(w io.Writer, d Dial, rotations []Rotation, delay time.Duration) error {
+	part1, part2 := d, d
+	part1.Method, part2.Method = EndOfRotation, EveryClick
+
+	steps1, err := part1.Trace(rotations)
+	if err != nil {
+		return err
+	}
+	steps2, _ := part2.Trace(rotations)
+
+	for i, s := range steps2 {
+		if _, err := io.WriteString(w, ansiClear+frame(part2, s, len(steps2), steps1[i].Total, s.Total)); err != nil {
+			return err
+		}
+		time.Sleep(delay)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 1: Dial Rendering
+ *
+ * Tests verify rendered stops and passes agree with both parts' counts.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// stops and passes split the example the way part 1 and part 2 differ
+func TestMarks(t *testing.T) {
+	d := SafeDial(EveryClick)
+	stops, passes := 0, 0
+	position := d.Start
+	for _, r := range exampleRotations {
+		for _, m := range d.marks(position, r) {
+			if m.stop {
+				stops += m.count
+			} else {
+				passes += m.count
+			}
+		}
+		position, _ = d.Turn(position, r)
+	}
+
+	if stops != 3 {
+		t.Errorf("stops = %d; expected 3", stops)
+	}
+	if stops+passes != 6 {
+		t.Errorf("stops + passes = %d; expected 6", stops+passes)
+	}
+}
+
+// a turn of several full circles draws one pass counting each circle
+func TestMarksFullTurns(t *testing.T) {
+	d := SafeDial(EveryClick)
+	tests := []struct {
+		start    int
+		rotation Rotation
+		expected []mark
+	}{
+		{50, Rotation{'R', 1000}, []mark{{target: 0, click: 50, count: 10}}},
+		{50, Rotation{'L', 250}, []mark{{target: 0, click: 50, count: 2}, {target: 0, click: 250, count: 1, stop: true}}},
+		{0, Rotation{'R', 100}, []mark{{target: 0, click: 100, count: 1, stop: true}}},
+		{50, Rotation{'R', 1_000_000_000}, []mark{{target: 0, click: 50, count: 10_000_000}}},
+	}
+
+	for _, tt := range tests {
+		got := d.marks(tt.start, tt.rotation)
+		if !slices.Equal(got, tt.expected) {
+			t.Errorf("marks(%d, %v) = %+v; expected %+v", tt.start, tt.rotation, got, tt.expected)
+		}
+		hits := 0
+		for _, m := range got {
+			hits += m.count
+		}
+		if _, want := d.Turn(tt.start, tt.rotation); hits != want {
+			t.Errorf("marks(%d, %v) count %d hits; Turn scores %d", tt.start, tt.rotation, hits, want)
+		}
+	}
+}
+
+// one filled dot per stop and one hollow dot per pass
+func TestWriteSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteSVG(&buf, SafeDial(EveryClick), exampleRotations); err != nil {
+		t.Fatalf("WriteSVG failed: %v", err)
+	}
+	svg := buf.String()
+
+	if !strings.HasPrefix(svg, "<svg") || !strings.HasSuffix(svg, "</svg>\n") {
+		t.Errorf("WriteSVG output is not a single svg element")
+	}
+	if n := strings.Count(svg, "<path "); n != len(exampleRotations) {
+		t.Errorf("arcs = %d; expected %d", n, len(exampleRotations))
+	}
+	if n := strings.Count(svg, `r="3" fill="#e33"`); n != 3 {
+		t.Errorf("stops drawn = %d; expected 3", n)
+	}
+	if n := strings.Count(svg, `r="3" fill="white"`); n != 3 {
+		t.Errorf("passes drawn = %d; expected 3", n)
+	}
+}
+
+// the last frame shows both parts' totals
+func TestAnimate(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Animate(&buf, SafeDial(EveryClick), exampleRotations, 0); err != nil {
+		t.Fatalf("Animate failed: %v", err)
+	}
+	frames := strings.Split(buf.String(), ansiClear)
+	if len(frames) != len(exampleRotations)+1 {
+		t.Fatalf("frames = %d; expected %d", len(frames)-1, len(exampleRotations))
+	}
+	last := frames[len(frames)-1]
+	if !strings.Contains(last, "part 1 (stops) 3   part 2 (every click) 6") {
+		t.Errorf("last frame missing totals:\n%s", last)
+	}
+}
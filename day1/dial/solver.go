@@ -0,0 +1,45 @@
+/**
+ * Advent of Code 2025 - Day 1: Solver Registration
+ *
+ * Plugs the dial simulation into the shared solver registry.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"io"
+
+	"solver"
+)
+
+func init() {
+	solver.Register(daySolver{})
+}
+
+// daySolver parses rotation lists for the shared registry
+type daySolver struct{}
+
+// puzzle is a parsed rotation list
+type puzzle []Rotation
+
+func (daySolver) Day() int      { return 1 }
+func (daySolver) Title() string { return "North Pole Security Dial" }
+
+func (daySolver) Parse(r io.Reader) (solver.Puzzle, error) {
+	rotations, err := Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return puzzle(rotations), nil
+}
+
+func (p puzzle) Part1() (solver.Answer, error) {
+	return solver.Int(SimulateDialPart1(p)), nil
+}
+
+func (p puzzle) Part2() (solver.Answer, error) {
+	return solver.Int(SimulateDialPart2(p)), nil
+}
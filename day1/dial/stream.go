@@ -0,0 +1,69 @@
+/**
+ * Advent of Code 2025 - Day 1: Streaming Evaluation
+ *
+ * Counts both passwords in a single pass over a reader without
+ * keeping the rotations. Plain instructions are counted as they are
+ * read; a repeat's body is held, unexpanded, until its ")" and may
+ * not expand to or hold more than maxRotations instructions, so
+ * memory is bounded by that rather than by the length of the input.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"io"
+	"os"
+)
+
+// Counter feeds rotations to a dial one at a time and keeps its count
+type Counter struct {
+	dial     Dial
+	Position int // where the dial points now
+	Total    int // hits so far
+}
+
+// returns a counter with the dial at its start position
+func (d Dial) NewCounter() (*Counter, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	return &Counter{dial: d, Position: d.Start}, nil
+}
+
+// turns the dial by one rotation and adds its hits
+func (c *Counter) Add(rotation Rotation) {
+	var hits int
+	c.Position, hits = c.dial.Turn(c.Position, rotation)
+	c.Total += hits
+}
+
+// parses rotations from r and counts both passwords as they arrive
+func StreamPasswords(r io.Reader) (int, int, error) {
+	part1, _ := SafeDial(EndOfRotation).NewCounter() // always valid
+	part2, _ := SafeDial(EveryClick).NewCounter()
+
+	err := ParseFunc(r, func(rotation Rotation) error {
+		part1.Add(rotation)
+		part2.Add(rotation)
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return part1.Total, part2.Total, nil
+}
+
+// streams a rotations file, see StreamPasswords
+func ReadPasswords(filename string) (int, int, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	return StreamPasswords(file)
+}
@@ -0,0 +1,173 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 1: Streaming Evaluation
+ *
+ * Tests verify streamed passwords match the slice-based simulation
+ * and that memory use does not grow with the input.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"solver/gen"
+)
+
+// streamed passwords match Parse followed by simulation
+func TestStreamPasswords(t *testing.T) {
+	inputs := []string{
+		"L68\nL30\nR48\nL5\nR60\nL55\nL1\nL99\nR14\nL82\n",
+		gen.Rotations(gen.New(16), 5000, 999),
+		"=0 3x(L100 R250) # extended\n=17, R83; 2x(L1000)\n",
+		"3x(2x(L7 R3) 4x(R11 2x(L-9)))",
+		"",
+	}
+
+	for _, input := range inputs {
+		rotations, err := Parse(strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+
+		part1, part2, err := StreamPasswords(strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("StreamPasswords failed: %v", err)
+		}
+		if want := SimulateDialPart1(rotations); part1 != want {
+			t.Errorf("streamed part 1 = %d; expected %d", part1, want)
+		}
+		if want := SimulateDialPart2(rotations); part2 != want {
+			t.Errorf("streamed part 2 = %d; expected %d", part2, want)
+		}
+	}
+}
+
+// syntax errors stop the stream
+func TestStreamPasswordsError(t *testing.T) {
+	var se *SyntaxError
+	if _, _, err := StreamPasswords(strings.NewReader("L1\nR2\nQ3\n")); !errors.As(err, &se) || se.Line != 3 {
+		t.Errorf("StreamPasswords error = %v; expected a SyntaxError on line 3", err)
+	}
+}
+
+// repeatReader yields the same line n times without holding the input
+type repeatReader struct {
+	line string
+	n    int
+	off  int
+}
+
+func (r *repeatReader) Read(p []byte) (int, error) {
+	written := 0
+	for written < len(p) && r.n > 0 {
+		c := copy(p[written:], r.line[r.off:])
+		written += c
+		r.off += c
+		if r.off == len(r.line) {
+			r.off = 0
+			r.n--
+		}
+	}
+	if written == 0 {
+		return 0, io.EOF
+	}
+	return written, nil
+}
+
+// allocations stay flat as the input grows a thousandfold
+func TestStreamPasswordsConstantMemory(t *testing.T) {
+	mallocs := func(n int) uint64 {
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		part1, part2, err := StreamPasswords(&repeatReader{line: "R10\n", n: n})
+		runtime.ReadMemStats(&after)
+
+		if err != nil {
+			t.Fatalf("StreamPasswords failed: %v", err)
+		}
+		// 50 clicks to the first zero, then one every 100
+		if want := (10*n + 50) / 100; part1 != want || part2 != want {
+			t.Fatalf("StreamPasswords(%d x R10) = %d, %d; expected %d", n, part1, part2, want)
+		}
+		return after.Mallocs - before.Mallocs
+	}
+
+	small, large := mallocs(1_000), mallocs(1_000_000)
+	if large > small+16 {
+		t.Errorf("1000000 rotations took %d allocations; 1000 took %d", large, small)
+	}
+}
+
+// nested repeats stream ten million rotations without expanding them
+func TestStreamPasswordsNestedRepeats(t *testing.T) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	part1, part2, err := StreamPasswords(strings.NewReader("10x(1000x(1000x(R7)))"))
+	runtime.ReadMemStats(&after)
+
+	if err != nil {
+		t.Fatalf("StreamPasswords failed: %v", err)
+	}
+	// every 100th rotation from the 50th stops on zero, and every 100
+	// clicks pass it
+	if part1 != 100_000 || part2 != 700_000 {
+		t.Errorf("StreamPasswords = %d, %d; expected 100000, 700000", part1, part2)
+	}
+	if n := after.Mallocs - before.Mallocs; n > 100 {
+		t.Errorf("nested repeats took %d allocations", n)
+	}
+
+	// a count past the bound is rejected rather than run
+	var se *SyntaxError
+	if _, _, err := StreamPasswords(strings.NewReader("999999999999x(L1)")); !errors.As(err, &se) {
+		t.Errorf("StreamPasswords error = %v; expected a SyntaxError", err)
+	}
+}
+
+// counter keeps the position between rotations
+func TestCounter(t *testing.T) {
+	c, err := SafeDial(EveryClick).NewCounter()
+	if err != nil {
+		t.Fatalf("NewCounter failed: %v", err)
+	}
+
+	c.Add(Rotation{'R', 60})
+	c.Add(Rotation{'L', 20})
+	if c.Position != 90 || c.Total != 2 {
+		t.Errorf("counter at %d with %d hits; expected 90 with 2", c.Position, c.Total)
+	}
+
+	if _, err := (Dial{}).NewCounter(); err == nil {
+		t.Errorf("NewCounter on zero dial expected error but got none")
+	}
+}
+
+// file wrapper
+func TestReadPasswords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rotations.txt")
+	if err := os.WriteFile(path, []byte("L68\nL30\nR48\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// L68 passes zero and R48 stops on it
+	part1, part2, err := ReadPasswords(path)
+	if err != nil {
+		t.Fatalf("ReadPasswords failed: %v", err)
+	}
+	if part1 != 1 || part2 != 2 {
+		t.Errorf("ReadPasswords = %d, %d; expected 1, 2", part1, part2)
+	}
+
+	if _, _, err := ReadPasswords("does_not_exist.txt"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadPasswords(missing) error = %v; expected os.ErrNotExist", err)
+	}
+}
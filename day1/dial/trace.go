@@ -0,0 +1,183 @@
+/**
+ * Advent of Code 2025 - Day 1: Rotation Traces
+ *
+ * Records what every rotation did to the dial so a wrong password
+ * can be tracked down to the rotation that caused it. Traces print
+ * as a table, export as CSV or JSON, or replay step by step.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Step is one rotation of a trace and its effect on the dial
+type Step struct {
+	Index    int      `json:"index"` // 1-based position in the input
+	Rotation Rotation `json:"rotation"`
+	Start    int      `json:"start"`
+	End      int      `json:"end"`
+	Hits     int      `json:"hits"`  // target hits during this rotation
+	Total    int      `json:"total"` // hits so far, the password after this step
+}
+
+// formats a rotation the way the input writes it, e.g. L68
+func (r Rotation) String() string {
+	return string(r.Direction) + strconv.Itoa(r.Distance)
+}
+
+func (r Rotation) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Rotation) UnmarshalText(text []byte) error {
+	if len(text) > 1 && text[0] == Set {
+		n, err := strconv.Atoi(string(text[1:]))
+		if err != nil {
+			return fmt.Errorf("invalid set position: %s", text[1:])
+		}
+		*r = Rotation{Direction: Set, Distance: n}
+		return nil
+	}
+
+	rotation, err := ParseRotation(string(text))
+	if err != nil {
+		return err
+	}
+	*r = rotation
+	return nil
+}
+
+// applies every rotation like Count, recording each one
+func (d Dial) Trace(rotations []Rotation) ([]Step, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+
+	steps := make([]Step, len(rotations))
+	position, total := d.Start, 0
+	for i, rotation := range rotations {
+		end, hits := d.Turn(position, rotation)
+		total += hits
+		steps[i] = Step{Index: i + 1, Rotation: rotation, Start: position, End: end, Hits: hits, Total: total}
+		position = end
+	}
+
+	return steps, nil
+}
+
+// prints steps as an aligned table
+func WriteTable(w io.Writer, steps []Step) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "#\trotation\tstart\tend\thits\ttotal\t")
+	for _, s := range steps {
+		fmt.Fprintf(tw, "%d\t%s\t%d\t%d\t%d\t%d\t\n", s.Index, s.Rotation, s.Start, s.End, s.Hits, s.Total)
+	}
+	return tw.Flush()
+}
+
+// writes steps as CSV with a header row
+func WriteCSV(w io.Writer, steps []Step) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"index", "rotation", "start", "end", "hits", "total"})
+	for _, s := range steps {
+		cw.Write([]string{
+			strconv.Itoa(s.Index),
+			s.Rotation.String(),
+			strconv.Itoa(s.Start),
+			strconv.Itoa(s.End),
+			strconv.Itoa(s.Hits),
+			strconv.Itoa(s.Total),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writes steps as an indented JSON array
+func WriteJSON(w io.Writer, steps []Step) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(steps)
+}
+
+// width of the dial gauge drawn by Replay
+const gaugeWidth = 50
+
+// draws the dial as a strip: ^ marks position, * the targets
+func gauge(d Dial, position int) string {
+	width := min(d.Size, gaugeWidth)
+	cell := func(p int) int { return p * width / d.Size }
+
+	strip := []byte(strings.Repeat("-", width))
+	for _, t := range d.Targets {
+		strip[cell(t)] = '*'
+	}
+	strip[cell(position)] = '^'
+
+	return "[" + string(strip) + "]"
+}
+
+// prints one step of a replay
+func writeStep(w io.Writer, d Dial, s Step, count int) {
+	fmt.Fprintf(w, "%d/%d  %-8s %3d -> %-3d %s  hits %d  total %d\n",
+		s.Index, count, s.Rotation, s.Start, s.End, gauge(d, s.End), s.Hits, s.Total)
+}
+
+// steps through a trace interactively, reading commands from in: an
+// empty line shows the next step, a number skips that many, a plays
+// the rest with delay between steps and q quits
+func Replay(in io.Reader, out io.Writer, d Dial, steps []Step, delay time.Duration) error {
+	fmt.Fprintf(out, "start %d %s\n", d.Start, gauge(d, d.Start))
+
+	scanner := bufio.NewScanner(in)
+	next := 0
+	for next < len(steps) {
+		fmt.Fprint(out, "[enter] next, <n> skip n, a autoplay, q quit> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+			return scanner.Err()
+		}
+
+		cmd := strings.TrimSpace(scanner.Text())
+		switch {
+		case cmd == "":
+			writeStep(out, d, steps[next], len(steps))
+			next++
+		case cmd == "q":
+			return nil
+		case cmd == "a":
+			for ; next < len(steps); next++ {
+				writeStep(out, d, steps[next], len(steps))
+				time.Sleep(delay)
+			}
+		default:
+			n, err := strconv.Atoi(cmd)
+			if err != nil || n < 1 {
+				fmt.Fprintf(out, "unknown command %q\n", cmd)
+				continue
+			}
+			next = min(next+n, len(steps))
+			writeStep(out, d, steps[next-1], len(steps))
+		}
+	}
+
+	if len(steps) > 0 {
+		fmt.Fprintf(out, "password %d\n", steps[len(steps)-1].Total)
+	} else {
+		fmt.Fprintln(out, "password 0")
+	}
+	return nil
+}
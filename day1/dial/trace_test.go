@@ -0,0 +1,147 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 1: Rotation Traces
+ *
+ * Tests verify traces match the dial counts and every output format.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package dial
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var exampleRotations = []Rotation{
+	{'L', 68}, {'L', 30}, {'R', 48}, {'L', 5}, {'R', 60},
+	{'L', 55}, {'L', 1}, {'L', 99}, {'R', 14}, {'L', 82},
+}
+
+// trace p2 example
+func TestTrace(t *testing.T) {
+	steps, err := SafeDial(EveryClick).Trace(exampleRotations)
+	if err != nil {
+		t.Fatalf("Trace failed: %v", err)
+	}
+
+	if len(steps) != len(exampleRotations) {
+		t.Fatalf("Trace has %d steps; expected %d", len(steps), len(exampleRotations))
+	}
+
+	first := Step{Index: 1, Rotation: Rotation{'L', 68}, Start: 50, End: 82, Hits: 1, Total: 1}
+	if steps[0] != first {
+		t.Errorf("first step = %+v; expected %+v", steps[0], first)
+	}
+
+	for i := 1; i < len(steps); i++ {
+		if steps[i].Start != steps[i-1].End {
+			t.Errorf("step %d starts at %d; previous ended at %d", i+1, steps[i].Start, steps[i-1].End)
+		}
+	}
+	if last := steps[len(steps)-1]; last.Total != 6 {
+		t.Errorf("final total = %d; expected 6", last.Total)
+	}
+}
+
+// invalid dial
+func TestTraceInvalidDial(t *testing.T) {
+	if _, err := (Dial{}).Trace(exampleRotations); err == nil {
+		t.Errorf("Trace on zero dial expected error but got none")
+	}
+}
+
+// table, csv and json
+func TestTraceFormats(t *testing.T) {
+	steps, err := SafeDial(EndOfRotation).Trace(exampleRotations[:3])
+	if err != nil {
+		t.Fatalf("Trace failed: %v", err)
+	}
+
+	var table bytes.Buffer
+	if err := WriteTable(&table, steps); err != nil {
+		t.Fatalf("WriteTable failed: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(table.String()), "\n"); len(lines) != 4 {
+		t.Errorf("table has %d lines; expected header and 3 rows:\n%s", len(lines), table.String())
+	}
+
+	var csv bytes.Buffer
+	if err := WriteCSV(&csv, steps); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	expected := "index,rotation,start,end,hits,total\n" +
+		"1,L68,50,82,0,0\n" +
+		"2,L30,82,52,0,0\n" +
+		"3,R48,52,0,1,1\n"
+	if csv.String() != expected {
+		t.Errorf("WriteCSV = %q; expected %q", csv.String(), expected)
+	}
+
+	var js bytes.Buffer
+	if err := WriteJSON(&js, steps); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	var decoded []Step
+	if err := json.Unmarshal(js.Bytes(), &decoded); err != nil {
+		t.Fatalf("JSON does not decode: %v", err)
+	}
+	for i := range steps {
+		if decoded[i] != steps[i] {
+			t.Errorf("decoded step %d = %+v; expected %+v", i, decoded[i], steps[i])
+		}
+	}
+	if !strings.Contains(js.String(), `"rotation": "L68"`) {
+		t.Errorf("JSON does not write rotations as text:\n%s", js.String())
+	}
+}
+
+// replay commands: next, skip, unknown, autoplay
+func TestReplay(t *testing.T) {
+	d := SafeDial(EveryClick)
+	steps, err := d.Trace(exampleRotations)
+	if err != nil {
+		t.Fatalf("Trace failed: %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := Replay(strings.NewReader("\n3\nx\na\n"), &out, d, steps, 0); err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+
+	for _, want := range []string{"1/10", "4/10", `unknown command "x"`, "10/10", "password 6"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("replay output missing %q:\n%s", want, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "2/10") {
+		t.Errorf("replay showed a skipped step:\n%s", out.String())
+	}
+}
+
+// quitting and running out of input stop early
+func TestReplayStopsEarly(t *testing.T) {
+	d := SafeDial(EveryClick)
+	steps, _ := d.Trace(exampleRotations)
+
+	for _, in := range []string{"\nq\n", "\n"} {
+		var out bytes.Buffer
+		if err := Replay(strings.NewReader(in), &out, d, steps, 0); err != nil {
+			t.Fatalf("Replay(%q) failed: %v", in, err)
+		}
+		if strings.Contains(out.String(), "password") || strings.Contains(out.String(), "2/10") {
+			t.Errorf("Replay(%q) went past the first step:\n%s", in, out.String())
+		}
+	}
+}
+
+// gauge marks position and targets
+func TestGauge(t *testing.T) {
+	d := Dial{Size: 10, Targets: []int{0, 5}}
+	if got := gauge(d, 3); got != "[*--^-*----]" {
+		t.Errorf("gauge = %s; expected [*--^-*----]", got)
+	}
+}
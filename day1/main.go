@@ -1,8 +1,10 @@
 /**
  * Advent of Code 2025 - Day 1: North Pole Security Dial
  *
- * This program solves the dial password problem by simulating
- * rotations and counting how many times the dial points at zero.
+ * Thin command wrapper that streams the puzzle input and prints
+ * both passwords using the dial package, traces one part's
+ * rotations with -trace and -replay, draws them with -svg and
+ * -animate, or treats the input as a multi-dial lock with -dials.
  *
  * Author: KleaSCM
  * Email: KleaSCM@gmail.com
@@ -11,137 +13,170 @@
 package main
 
 import (
-	"bufio"
+	"flag"
 	"fmt"
 	"os"
-	"strconv"
-	"strings"
-)
+	"time"
 
-// Rotation represents a single dial rotation instruction
-type Rotation struct {
-	Direction byte // 'L' for left, 'R' for right
-	Distance  int  // Number of clicks to rotate
-}
+	"day1/dial"
+)
 
-// reads and parses rotation instructions from file
-func ReadRotations(filename string) ([]Rotation, error) {
-	file, err := os.Open(filename)
-	if err != nil {
-		return nil, err
+func main() {
+	input := flag.String("input", "input/input.txt", "rotations file, - for stdin")
+	part := flag.Int("part", 2, "part whose counting method -trace and -replay use")
+	trace := flag.String("trace", "", "print a rotation trace as table, csv or json")
+	replay := flag.Bool("replay", false, "step through the rotation trace interactively")
+	dials := flag.Int("dials", 0, "treat the input as a lock of this many dials, addressed as 2:L15")
+	svg := flag.String("svg", "", "draw the rotations as an SVG to this file")
+	animate := flag.Bool("animate", false, "animate the rotations on the terminal")
+	limit := flag.Int("limit", 0, "draw or animate only the first n rotations, 0 for all")
+	delay := flag.Duration("delay", 200*time.Millisecond, "pause between steps when autoplaying a replay or animating")
+	flag.Parse()
+
+	if *svg != "" || *animate {
+		rotations, err := readRotations(*input)
+		if err != nil {
+			fmt.Printf("Error reading input: %v\n", err)
+			os.Exit(1)
+		}
+		if *limit > 0 && *limit < len(rotations) {
+			rotations = rotations[:*limit]
+		}
+		if err := render(rotations, *svg, *animate, *delay); err != nil {
+			fmt.Printf("Error rendering rotations: %v\n", err)
+			os.Exit(1)
+		}
+		return
 	}
-	defer file.Close()
-
-	var rotations []Rotation
-	scanner := bufio.NewScanner(file)
 
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
+	if *trace != "" || *replay {
+		rotations, err := readRotations(*input)
+		if err != nil {
+			fmt.Printf("Error reading input: %v\n", err)
+			os.Exit(1)
+		}
+		if err := traceRotations(rotations, *part, *trace, *replay, *delay); err != nil {
+			fmt.Printf("Error tracing rotations: %v\n", err)
+			os.Exit(1)
 		}
+		return
+	}
 
-		rotation, err := ParseRotation(line)
-		if err != nil {
-			return nil, err
+	if *dials > 0 {
+		if err := openLock(*input, *dials); err != nil {
+			fmt.Printf("Error simulating lock: %v\n", err)
+			os.Exit(1)
 		}
-		rotations = append(rotations, rotation)
+		return
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, err
+	// both parts in one pass, so inputs of any size fit in memory
+	var part1Result, part2Result int
+	var err error
+	if *input == "-" {
+		part1Result, part2Result, err = dial.StreamPasswords(os.Stdin)
+	} else {
+		part1Result, part2Result, err = dial.ReadPasswords(*input)
+	}
+	if err != nil {
+		fmt.Printf("Error reading input: %v\n", err)
+		os.Exit(1)
 	}
 
-	return rotations, nil
+	// p1: count zeros at end of rotations
+	fmt.Printf("Password (p1): %d\n", part1Result)
+
+	// p2: count all zeros during rotations
+	fmt.Printf("Password (p2): %d\n", part2Result)
 }
 
-// converts line like "L68" or "R48" to a struct
-func ParseRotation(line string) (Rotation, error) {
-	if len(line) < 2 {
-		return Rotation{}, fmt.Errorf("invalid rotation format: %s", line)
+// reads every rotation from filename, "-" meaning stdin
+func readRotations(filename string) ([]dial.Rotation, error) {
+	if filename == "-" {
+		return dial.Parse(os.Stdin)
 	}
+	return dial.ReadRotations(filename)
+}
 
-	direction := line[0]
-	if direction != 'L' && direction != 'R' {
-		return Rotation{}, fmt.Errorf("invalid direction: %c", direction)
+// traces the rotations on the safe dial for part and prints or replays it
+func traceRotations(rotations []dial.Rotation, part int, format string, replay bool, delay time.Duration) error {
+	method := dial.EveryClick
+	switch part {
+	case 1:
+		method = dial.EndOfRotation
+	case 2:
+	default:
+		return fmt.Errorf("invalid part %d (expected 1 or 2)", part)
 	}
 
-	distance, err := strconv.Atoi(line[1:])
+	d := dial.SafeDial(method)
+	steps, err := d.Trace(rotations)
 	if err != nil {
-		return Rotation{}, fmt.Errorf("invalid distance: %s", line[1:])
+		return err
 	}
 
-	return Rotation{Direction: direction, Distance: distance}, nil
-}
-
-// counts how many times the dial ends at position 0
-// after each complete rotation (p1)
-func SimulateDialPart1(rotations []Rotation) int {
-	position := 50
-	zeroCount := 0
-
-	for _, rotation := range rotations {
-		if rotation.Direction == 'L' {
-			position = (position - rotation.Distance) % 100
-			if position < 0 {
-				position += 100
-			}
-		} else if rotation.Direction == 'R' {
-			position = (position + rotation.Distance) % 100
-		}
-
-		if position == 0 {
-			zeroCount++
-		}
+	if replay {
+		return dial.Replay(os.Stdin, os.Stdout, d, steps, delay)
 	}
 
-	return zeroCount
+	switch format {
+	case "table":
+		return dial.WriteTable(os.Stdout, steps)
+	case "csv":
+		return dial.WriteCSV(os.Stdout, steps)
+	case "json":
+		return dial.WriteJSON(os.Stdout, steps)
+	}
+	return fmt.Errorf("unknown trace format %q (expected table, csv or json)", format)
 }
 
-// counts every time the dial points at 0 during any rotation,
-// including intermediate positions (p2)
-func SimulateDialPart2(rotations []Rotation) int {
-	position := 50
-	zeroCount := 0
-
-	for _, rotation := range rotations {
-		if rotation.Direction == 'L' {
-			// Moving left (decreasing)
-			for step := 1; step <= rotation.Distance; step++ {
-				position = (position - 1) % 100
-				if position < 0 {
-					position += 100
-				}
-				if position == 0 {
-					zeroCount++
-				}
-			}
-		} else if rotation.Direction == 'R' {
-			// Moving right (increasing)
-			for step := 1; step <= rotation.Distance; step++ {
-				position = (position + 1) % 100
-				if position == 0 {
-					zeroCount++
-				}
-			}
+// writes the rotations as an SVG to filename and/or animates them
+func render(rotations []dial.Rotation, filename string, animate bool, delay time.Duration) error {
+	d := dial.SafeDial(dial.EveryClick)
+	if filename != "" {
+		f, err := os.Create(filename)
+		if err != nil {
+			return err
+		}
+		if err := dial.WriteSVG(f, d, rotations); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
 		}
 	}
-
-	return zeroCount
+	if animate {
+		return dial.Animate(os.Stdout, d, rotations, delay)
+	}
+	return nil
 }
 
-func main() {
-	// p1: count zeros at end of rotations
-	rotations, err := ReadRotations("input/input.txt")
+// runs lock instructions on n safe dials and prints both passwords
+// with each dial's own count
+func openLock(filename string, n int) error {
+	var moves []dial.Move
+	var err error
+	if filename == "-" {
+		moves, err = dial.ParseMoves(os.Stdin)
+	} else {
+		moves, err = dial.ReadMoves(filename)
+	}
 	if err != nil {
-		fmt.Printf("Error reading input: %v\n", err)
-		os.Exit(1)
+		return err
 	}
 
-	part1Result := SimulateDialPart1(rotations)
-	fmt.Printf("Password (p1): %d\n", part1Result)
-
-	// p2: count all zeros during rotations
-	part2Result := SimulateDialPart2(rotations)
-	fmt.Printf("Password (p2): %d\n", part2Result)
+	for part, method := range []dial.Method{dial.EndOfRotation, dial.EveryClick} {
+		c, err := dial.SafeLock(n, method).NewCounter()
+		if err != nil {
+			return err
+		}
+		for _, m := range moves {
+			if err := c.Add(m); err != nil {
+				return err
+			}
+		}
+		fmt.Printf("Lock password (p%d): %d, per dial %v\n", part+1, c.Total, c.Hits)
+	}
+	return nil
 }
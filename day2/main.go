@@ -1,8 +1,12 @@
 /**
  * Advent of Code 2025 - Day 2: Invalid Product IDs
  *
- * This program identifies invalid product IDs in given ranges.
- * An ID is invalid if it consists of a repeated digit pattern.
+ * Thin command wrapper that reads the puzzle input and prints
+ * both invalid ID sums using the productid package, lists each
+ * range's invalid IDs with -list, or sums the IDs a named rule
+ * marks invalid with -rule. -unique also reports sums with
+ * overlapping ranges merged so each ID counts once, and -base,
+ * -input-base and -density work with IDs in other bases.
  *
  * Author: KleaSCM
  * Email: KleaSCM@gmail.com
@@ -11,180 +15,179 @@
 package main
 
 import (
-	"bufio"
+	"context"
+	"flag"
 	"fmt"
 	"os"
-	"strconv"
-	"strings"
-)
-
-// IDRange represents a range of product IDs from Start to End inclusive
-type IDRange struct {
-	Start, End int
-}
+	"os/signal"
+	"time"
 
-// converts a string like "11-22" into a struct
-func ParseIDRange(rangeStr string) (IDRange, error) {
-	parts := strings.Split(rangeStr, "-")
-	if len(parts) != 2 {
-		return IDRange{}, fmt.Errorf("invalid range format: %s", rangeStr)
-	}
-
-	start, err1 := strconv.Atoi(strings.TrimSpace(parts[0]))
-	end, err2 := strconv.Atoi(strings.TrimSpace(parts[1]))
+	"day2/productid"
+)
 
-	if err1 != nil || err2 != nil {
-		return IDRange{}, fmt.Errorf("invalid numbers in range: %s", rangeStr)
+func main() {
+	input := flag.String("input", "input/input.txt", "ID ranges file, - for stdin")
+	list := flag.Bool("list", false, "list each range's invalid IDs with their patterns")
+	part := flag.Int("part", 2, "part whose rule -list uses")
+	limit := flag.Int("limit", 20, "most invalid IDs -list prints per range, 0 for all")
+	rule := flag.String("rule", "", "sum the IDs this rule marks invalid, e.g. and(part2, not(palindrome))")
+	rules := flag.Bool("rules", false, "list the rules -rule understands")
+	workers := flag.Int("workers", 0, "goroutines scanning for -rule, 0 for one per CPU")
+	timeout := flag.Duration("timeout", 0, "give up a -rule scan after this long, 0 for never")
+	unique := flag.Bool("unique", false, "also sum with overlapping ranges merged, and list merged ranges")
+	base := flag.Int("base", 10, "base IDs are checked for repeats in, 2-36")
+	inputBase := flag.Int("input-base", 10, "base the ranges are written in, 2-36 (0x allowed in 16)")
+	density := flag.Bool("density", false, "compare invalid ID counts across bases 2-36")
+	flag.Parse()
+
+	if *rules {
+		fmt.Println("Rules:")
+		productid.WriteRules(os.Stdout)
+		return
 	}
 
-	if start > end {
-		return IDRange{}, fmt.Errorf("start > end in range: %s", rangeStr)
+	// Read all ID ranges from input file, as big integers if they need it
+	all, err := readRanges(*input, *inputBase)
+	if err != nil {
+		fmt.Printf("Error reading input: %v\n", err)
+		os.Exit(1)
 	}
+	ranges := all.IDs
 
-	return IDRange{Start: start, End: end}, nil
-}
-
-// ParseIDRanges converts a comma-separated line of ranges into a slice of IDRange
-func ParseIDRanges(line string) ([]IDRange, error) {
-	line = strings.TrimSpace(line)
-	if line == "" {
-		return nil, nil
+	if all.IsBig() && (*rule != "" || *list || *density || *base != 10) {
+		fmt.Println("Error: -rule, -list, -density and -base need IDs and sums that fit in 64 bits")
+		os.Exit(1)
 	}
 
-	rangeStrings := strings.Split(line, ",")
-	var ranges []IDRange
-
-	for _, rangeStr := range rangeStrings {
-		rangeStr = strings.TrimSpace(rangeStr)
-		if rangeStr == "" {
-			continue
+	if *rule != "" {
+		isInvalid, err := productid.ParseRule(*rule)
+		if err != nil {
+			fmt.Printf("Error parsing rule: %v\n", err)
+			os.Exit(1)
 		}
-
-		idRange, err := ParseIDRange(rangeStr)
+		sum, err := scanRule(ranges, isInvalid, *workers, *timeout)
 		if err != nil {
-			return nil, err
+			fmt.Printf("Error scanning ranges: %v\n", err)
+			os.Exit(1)
 		}
-		ranges = append(ranges, idRange)
-	}
-
-	return ranges, nil
-}
-
-// checks if an ID is invalid for p1
-// an ID is invalid if it consists of exactly two identical halves
-func IsInvalidIDPart1(id int) bool {
-	s := strconv.Itoa(id)
-	length := len(s)
-
-	// Must be even length to be split into two equal halves
-	if length%2 != 0 {
-		return false
+		if !*unique {
+			fmt.Printf("Sum of invalid IDs (%s): %d\n", *rule, sum)
+			return
+		}
+		uniqueSum, err := scanRule(productid.MergeRanges(ranges), isInvalid, *workers, *timeout)
+		if err != nil {
+			fmt.Printf("Error scanning ranges: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Sum of invalid IDs (%s): %d, unique %d\n", *rule, sum, uniqueSum)
+		return
 	}
 
-	half := length / 2
-	firstHalf := s[:half]
-	secondHalf := s[half:]
-
-	return firstHalf == secondHalf
-}
-
-// checks if an ID is invalid for p2
-// an ID is invalid if it consists of two or more repetitions of the same digit pattern
-func IsInvalidIDPart2(id int) bool {
-	s := strconv.Itoa(id)
-	length := len(s)
-
-	// Try all possible pattern lengths that divide the total length
-	for patternLen := 1; patternLen <= length/2; patternLen++ {
-		if length%patternLen != 0 {
-			continue
+	if *list {
+		if *unique {
+			ranges = productid.MergeRanges(ranges)
 		}
-
-		pattern := s[:patternLen]
-		repeatCount := length / patternLen
-
-		// Must repeat at least twice
-		if repeatCount < 2 {
-			continue
+		if err := productid.WriteReport(os.Stdout, ranges, *part, *limit); err != nil {
+			fmt.Printf("Error listing invalid IDs: %v\n", err)
+			os.Exit(1)
 		}
+		return
+	}
 
-		// Check if the entire string matches this repeated pattern
-		allMatch := true
-		for i := 0; i < length; i += patternLen {
-			if s[i:i+patternLen] != pattern {
-				allMatch = false
-				break
-			}
+	if *density {
+		var bases []int
+		for b := 2; b <= 36; b++ {
+			bases = append(bases, b)
+		}
+		if err := productid.WriteDensity(os.Stdout, ranges, bases); err != nil {
+			fmt.Printf("Error counting invalid IDs: %v\n", err)
+			os.Exit(1)
 		}
+		return
+	}
 
-		if allMatch {
-			return true
+	if *base != 10 {
+		if err := printBaseSums(ranges, *base, *unique); err != nil {
+			fmt.Printf("Error summing invalid IDs: %v\n", err)
+			os.Exit(1)
 		}
+		return
 	}
 
-	return false
-}
+	// p1: sum IDs that are exactly two identical halves
+	part1Sum := all.SumPart1()
 
-// calculates the sum of all invalid IDs within the given ranges
-func SumInvalidIDsInRanges(ranges []IDRange, isInvalidFunc func(int) bool) int64 {
-	var sum int64
+	// p2: sum IDs that are two or more repetitions of any pattern
+	part2Sum := all.SumPart2()
 
-	for _, idRange := range ranges {
-		for id := idRange.Start; id <= idRange.End; id++ {
-			if isInvalidFunc(id) {
-				sum += int64(id)
-			}
-		}
+	if !*unique {
+		fmt.Printf("Sum of invalid IDs (p1): %d\n", part1Sum)
+		fmt.Printf("Sum of invalid IDs (p2): %d\n", part2Sum)
+		return
 	}
 
-	return sum
+	// overlapping ranges count their shared IDs once
+	merged := all.Unique()
+	fmt.Printf("Sum of invalid IDs (p1): %d, unique %d\n", part1Sum, merged.SumPart1())
+	fmt.Printf("Sum of invalid IDs (p2): %d, unique %d\n", part2Sum, merged.SumPart2())
 }
 
-// reads the input file and processes all ID ranges
-func ReadAndProcessRanges(filename string) ([]IDRange, error) {
-	file, err := os.Open(filename)
-	if err != nil {
-		return nil, err
+// reads ranges from filename, "-" meaning stdin, at the precision
+// their sums need
+func readRanges(filename string, base int) (productid.Ranges, error) {
+	r := os.Stdin
+	if filename != "-" {
+		f, err := os.Open(filename)
+		if err != nil {
+			return productid.Ranges{}, err
+		}
+		defer f.Close()
+		r = f
 	}
-	defer file.Close()
-
-	var allRanges []IDRange
-	scanner := bufio.NewScanner(file)
 
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
-		}
+	return productid.ParseRangesBase(r, base)
+}
 
-		ranges, err := ParseIDRanges(line)
+// prints both parts' sums with IDs checked in base, and the sums over
+// merged ranges as well if unique
+func printBaseSums(ranges []productid.IDRange, base int, unique bool) error {
+	sums := func(ranges []productid.IDRange) (int64, int64, error) {
+		part1, err := productid.SumInvalidIDsPart1Base(ranges, base)
 		if err != nil {
-			return nil, err
+			return 0, 0, err
 		}
-
-		allRanges = append(allRanges, ranges...)
+		part2, err := productid.SumInvalidIDsPart2Base(ranges, base)
+		return part1, part2, err
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, err
+	part1, part2, err := sums(ranges)
+	if err != nil {
+		return err
+	}
+	if !unique {
+		fmt.Printf("Sum of invalid IDs in base %d (p1): %d\n", base, part1)
+		fmt.Printf("Sum of invalid IDs in base %d (p2): %d\n", base, part2)
+		return nil
 	}
 
-	return allRanges, nil
-}
-
-func main() {
-	// Read all ID ranges from input file
-	ranges, err := ReadAndProcessRanges("input/input.txt")
+	unique1, unique2, err := sums(productid.MergeRanges(ranges))
 	if err != nil {
-		fmt.Printf("Error reading input: %v\n", err)
-		os.Exit(1)
+		return err
 	}
+	fmt.Printf("Sum of invalid IDs in base %d (p1): %d, unique %d\n", base, part1, unique1)
+	fmt.Printf("Sum of invalid IDs in base %d (p2): %d, unique %d\n", base, part2, unique2)
+	return nil
+}
 
-	// p1: sum IDs that are exactly two identical halves
-	part1Sum := SumInvalidIDsInRanges(ranges, IsInvalidIDPart1)
-	fmt.Printf("Sum of invalid IDs (p1): %d\n", part1Sum)
-
-	// p2: sum IDs that are two or more repetitions of any pattern
-	part2Sum := SumInvalidIDsInRanges(ranges, IsInvalidIDPart2)
-	fmt.Printf("Sum of invalid IDs (p2): %d\n", part2Sum)
+// sums the IDs isInvalid reports on a pool of workers, since arbitrary
+// rules have no closed form. Stops on interrupt or after timeout
+func scanRule(ranges []productid.IDRange, isInvalid productid.Rule, workers int, timeout time.Duration) (int64, error) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return productid.Pool{Workers: workers}.Sum(ctx, ranges, isInvalid)
 }
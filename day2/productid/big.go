@@ -0,0 +1,270 @@
+/**
+ * Advent of Code 2025 - Day 2: Arbitrary-Precision ID Ranges
+ *
+ * IDs longer than 19 digits do not fit in an int, and sums of large
+ * ranges can overflow int64 even when the IDs fit. This file repeats
+ * parsing, detection and the closed-form sums with math/big, and
+ * ParseRanges picks that mode automatically when the input needs it.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// BigIDRange is an IDRange whose IDs may not fit in 64 bits
+type BigIDRange struct {
+	Start, End *big.Int
+}
+
+// converts a string like "11-22" into a BigIDRange
+func ParseBigIDRange(rangeStr string) (BigIDRange, error) {
+	parts := strings.Split(rangeStr, "-")
+	if len(parts) != 2 {
+		return BigIDRange{}, fmt.Errorf("invalid range format: %s", rangeStr)
+	}
+
+	start, ok1 := new(big.Int).SetString(strings.TrimSpace(parts[0]), 10)
+	end, ok2 := new(big.Int).SetString(strings.TrimSpace(parts[1]), 10)
+	if !ok1 || !ok2 {
+		return BigIDRange{}, fmt.Errorf("invalid numbers in range: %s", rangeStr)
+	}
+
+	if start.Cmp(end) > 0 {
+		return BigIDRange{}, fmt.Errorf("start > end in range: %s", rangeStr)
+	}
+
+	return BigIDRange{Start: start, End: end}, nil
+}
+
+// converts a comma-separated line of ranges into BigIDRanges
+func ParseBigIDRanges(line string) ([]BigIDRange, error) {
+	return parseRangeList(line, ParseBigIDRange)
+}
+
+// parses every comma-separated range line from any reader as BigIDRanges
+func ParseBig(r io.Reader) ([]BigIDRange, error) {
+	return parseRangeLines(r, ParseBigIDRange)
+}
+
+// widens int ranges to BigIDRanges
+func ToBig(ranges []IDRange) []BigIDRange {
+	wide := make([]BigIDRange, len(ranges))
+	for i, r := range ranges {
+		wide[i] = BigIDRange{Start: big.NewInt(int64(r.Start)), End: big.NewInt(int64(r.End))}
+	}
+	return wide
+}
+
+// checks if a big ID is two identical halves (p1)
+func IsInvalidBigIDPart1(id *big.Int) bool {
+	s := id.String()
+	return len(s)%2 == 0 && repeatsExactly(s, 2)
+}
+
+// checks if a big ID is two or more repeats of a pattern (p2)
+func IsInvalidBigIDPart2(id *big.Int) bool {
+	s := id.String()
+	return shortestPeriod(s) < len(s)
+}
+
+var bigOne = big.NewInt(1)
+
+func bigPow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// big sumRepeats: IDs in [a, b] that are a k-digit pattern repeated m times
+func sumRepeatsBig(a, b *big.Int, k, m int) *big.Int {
+	// (10^(km) - 1) / (10^k - 1) = 1 + 10^k + ... + 10^(k(m-1))
+	r := new(big.Int).Sub(bigPow10(k*m), bigOne)
+	r.Quo(r, new(big.Int).Sub(bigPow10(k), bigOne))
+
+	lo, rem := new(big.Int).QuoRem(a, r, new(big.Int))
+	if rem.Sign() != 0 {
+		lo.Add(lo, bigOne)
+	}
+	if first := bigPow10(k - 1); lo.Cmp(first) < 0 {
+		lo = first
+	}
+	hi := new(big.Int).Quo(b, r)
+	if last := new(big.Int).Sub(bigPow10(k), bigOne); hi.Cmp(last) > 0 {
+		hi = last
+	}
+	if lo.Cmp(hi) > 0 {
+		return new(big.Int)
+	}
+
+	// r * (hi - lo + 1) * (lo + hi) / 2, the product is always even
+	n := new(big.Int).Sub(hi, lo)
+	n.Add(n, bigOne)
+	sum := new(big.Int).Add(lo, hi)
+	sum.Mul(sum, n).Mul(sum, r)
+	return sum.Rsh(sum, 1)
+}
+
+// big sumByLength: splits r into runs of equal digit count
+func sumByLengthBig(r BigIDRange, sumLength func(a, b *big.Int, length int) *big.Int) *big.Int {
+	total := new(big.Int)
+	start := r.Start
+	if start.Sign() <= 0 {
+		start = bigOne
+	}
+	if start.Cmp(r.End) > 0 {
+		return total
+	}
+
+	for length := len(start.String()); length <= len(r.End.String()); length++ {
+		a := start
+		if first := bigPow10(length - 1); a.Cmp(first) < 0 {
+			a = first
+		}
+		b := r.End
+		if last := new(big.Int).Sub(bigPow10(length), bigOne); b.Cmp(last) > 0 {
+			b = last
+		}
+		total.Add(total, sumLength(a, b, length))
+	}
+	return total
+}
+
+// sums the IDs in r made of exactly two identical halves (p1)
+func SumBigRangePart1(r BigIDRange) *big.Int {
+	return sumByLengthBig(r, func(a, b *big.Int, length int) *big.Int {
+		if length%2 != 0 {
+			return new(big.Int)
+		}
+		return sumRepeatsBig(a, b, length/2, 2)
+	})
+}
+
+// sums the IDs in r made of two or more repeats of a pattern (p2),
+// with the same inclusion-exclusion as sumAnyRepeats
+func SumBigRangePart2(r BigIDRange) *big.Int {
+	return sumByLengthBig(r, func(a, b *big.Int, length int) *big.Int {
+		primes := primeFactors(length)
+		sum := new(big.Int)
+		for subset := 1; subset < 1<<len(primes); subset++ {
+			m, bits := 1, 0
+			for i, p := range primes {
+				if subset&(1<<i) != 0 {
+					m *= p
+					bits++
+				}
+			}
+			if bits%2 == 1 {
+				sum.Add(sum, sumRepeatsBig(a, b, length/m, m))
+			} else {
+				sum.Sub(sum, sumRepeatsBig(a, b, length/m, m))
+			}
+		}
+		return sum
+	})
+}
+
+// sums the p1 invalid IDs across big ranges
+func SumInvalidBigIDsPart1(ranges []BigIDRange) *big.Int {
+	total := new(big.Int)
+	for _, r := range ranges {
+		total.Add(total, SumBigRangePart1(r))
+	}
+	return total
+}
+
+// sums the p2 invalid IDs across big ranges
+func SumInvalidBigIDsPart2(ranges []BigIDRange) *big.Int {
+	total := new(big.Int)
+	for _, r := range ranges {
+		total.Add(total, SumBigRangePart2(r))
+	}
+	return total
+}
+
+// Ranges holds parsed ranges at the precision the input needs: IDs
+// when every ID and every sum fits in 64 bits, Big otherwise
+type Ranges struct {
+	IDs []IDRange
+	Big []BigIDRange
+}
+
+// reports whether the ranges needed arbitrary precision
+func (rs Ranges) IsBig() bool {
+	return rs.Big != nil
+}
+
+// parses ranges from any reader, switching to big IDs when an ID
+// overflows an int or the ranges could sum past int64
+func ParseRanges(r io.Reader) (Ranges, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Ranges{}, err
+	}
+
+	ranges, err := Parse(bytes.NewReader(data))
+	if errors.Is(err, strconv.ErrRange) {
+		wide, err := ParseBig(bytes.NewReader(data))
+		if err != nil {
+			return Ranges{}, err
+		}
+		return Ranges{Big: wide}, nil
+	}
+	if err != nil {
+		return Ranges{}, err
+	}
+
+	if !sumsFitInt64(ranges) {
+		return Ranges{Big: ToBig(ranges)}, nil
+	}
+	return Ranges{IDs: ranges}, nil
+}
+
+// reads the input file with ParseRanges
+func ReadRanges(filename string) (Ranges, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return Ranges{}, err
+	}
+	defer file.Close()
+
+	return ParseRanges(file)
+}
+
+// bounds every invalid ID sum by the sum of every ID in the ranges,
+// count times largest, so the int64 closed form cannot overflow
+func sumsFitInt64(ranges []IDRange) bool {
+	bound := new(big.Int)
+	for _, r := range ranges {
+		n := new(big.Int).Sub(big.NewInt(int64(r.End)), big.NewInt(int64(r.Start)))
+		n.Add(n, bigOne)
+		bound.Add(bound, n.Mul(n, big.NewInt(int64(r.End))))
+	}
+	return bound.Cmp(big.NewInt(math.MaxInt64)) <= 0
+}
+
+// sums the p1 invalid IDs in whichever precision the ranges use
+func (rs Ranges) SumPart1() *big.Int {
+	if rs.IsBig() {
+		return SumInvalidBigIDsPart1(rs.Big)
+	}
+	return big.NewInt(SumInvalidIDsPart1(rs.IDs))
+}
+
+// sums the p2 invalid IDs in whichever precision the ranges use
+func (rs Ranges) SumPart2() *big.Int {
+	if rs.IsBig() {
+		return SumInvalidBigIDsPart2(rs.Big)
+	}
+	return big.NewInt(SumInvalidIDsPart2(rs.IDs))
+}
@@ -0,0 +1,132 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 2: Arbitrary-Precision ID Ranges
+ *
+ * Tests verify big sums agree with the int sums and a big scan, and
+ * that ParseRanges switches precision only when it must.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"errors"
+	"math/big"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// scans every ID in a big range
+func scanBig(r BigIDRange, isInvalid func(*big.Int) bool) *big.Int {
+	sum := new(big.Int)
+	for id := new(big.Int).Set(r.Start); id.Cmp(r.End) <= 0; id.Add(id, bigOne) {
+		if isInvalid(id) {
+			sum.Add(sum, id)
+		}
+	}
+	return sum
+}
+
+func mustBig(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("bad big int " + s)
+	}
+	return n
+}
+
+// big sums agree with int sums where both apply
+func TestBigMatchesInt(t *testing.T) {
+	rng := rand.New(rand.NewPCG(2, 22))
+	for i := 0; i < 500; i++ {
+		start := rng.IntN(pow10[1+rng.IntN(17)])
+		r := IDRange{start, start + rng.IntN(pow10[rng.IntN(9)])}
+		wide := ToBig([]IDRange{r})[0]
+
+		if got, want := SumBigRangePart1(wide), big.NewInt(SumRangePart1(r)); got.Cmp(want) != 0 {
+			t.Errorf("SumBigRangePart1(%+v) = %s; expected %s", r, got, want)
+		}
+		if got, want := SumBigRangePart2(wide), big.NewInt(SumRangePart2(r)); got.Cmp(want) != 0 {
+			t.Errorf("SumBigRangePart2(%+v) = %s; expected %s", r, got, want)
+		}
+	}
+}
+
+// IDs past 64 bits, around 24-digit repeats of 12 and 123456789012
+func TestBigMatchesScan(t *testing.T) {
+	ranges := []BigIDRange{
+		{mustBig("121212121212121212120000"), mustBig("121212121212121212122000")},
+		{mustBig("123456789012123456789000"), mustBig("123456789012123456790000")},
+		{mustBig("99999999999999999999998"), mustBig("100000000000000000000100")},
+	}
+
+	for _, r := range ranges {
+		if got, want := SumBigRangePart1(r), scanBig(r, IsInvalidBigIDPart1); got.Cmp(want) != 0 {
+			t.Errorf("SumBigRangePart1(%s-%s) = %s; expected %s", r.Start, r.End, got, want)
+		}
+		if got, want := SumBigRangePart2(r), scanBig(r, IsInvalidBigIDPart2); got.Cmp(want) != 0 {
+			t.Errorf("SumBigRangePart2(%s-%s) = %s; expected %s", r.Start, r.End, got, want)
+		}
+	}
+}
+
+// precision is chosen from the input
+func TestParseRanges(t *testing.T) {
+	tests := []struct {
+		input string
+		big   bool
+		part1 string
+	}{
+		{"11-22,95-115", false, "132"},
+		{"1-9999999999", true, "495495949990950"},
+		{"12121212121212121212-12121212121212121212", true, "12121212121212121212"},
+		{"11-22\n1111111111111111111111-1111111111111111111111", true, "1111111111111111111144"},
+	}
+
+	for _, test := range tests {
+		rs, err := ParseRanges(strings.NewReader(test.input))
+		if err != nil {
+			t.Errorf("ParseRanges(%q) unexpected error: %v", test.input, err)
+			continue
+		}
+		if rs.IsBig() != test.big {
+			t.Errorf("ParseRanges(%q).IsBig() = %v; expected %v", test.input, rs.IsBig(), test.big)
+		}
+		if got := rs.SumPart1().String(); got != test.part1 {
+			t.Errorf("ParseRanges(%q).SumPart1() = %s; expected %s", test.input, got, test.part1)
+		}
+	}
+}
+
+// malformed input still fails in either precision
+func TestParseRangesErrors(t *testing.T) {
+	for _, input := range []string{"abc-12", "22-11", "99999999999999999999-1", "1-2-3"} {
+		if _, err := ParseRanges(strings.NewReader(input)); err == nil {
+			t.Errorf("ParseRanges(%q) expected error but got none", input)
+		}
+	}
+}
+
+// file wrapper
+func TestReadRanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ranges.txt")
+	if err := os.WriteFile(path, []byte("11-22\n1111111111111111111111-1111111111111111111111\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, err := ReadRanges(path)
+	if err != nil {
+		t.Fatalf("ReadRanges failed: %v", err)
+	}
+	if !rs.IsBig() || rs.SumPart1().String() != "1111111111111111111144" {
+		t.Errorf("ReadRanges sum = %s; expected 1111111111111111111144 in big precision", rs.SumPart1())
+	}
+
+	if _, err := ReadRanges("does_not_exist.txt"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadRanges(missing) error = %v; expected os.ErrNotExist", err)
+	}
+}
@@ -0,0 +1,199 @@
+/**
+ * Advent of Code 2025 - Day 2: Closed-Form Invalid ID Sums
+ *
+ * Sums invalid IDs without visiting every ID in a range. An ID of
+ * length k*m made of a k-digit pattern p repeated m times equals
+ * p * (1 + 10^k + ... + 10^(k(m-1))), so the invalid IDs of one
+ * shape in a range are an arithmetic series of patterns times that
+ * repunit. Part 2 combines shapes by inclusion-exclusion, since an
+ * ID like 111111 repeats with several pattern lengths. The same
+ * holds with digits in any base, so the sums take a radix.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"fmt"
+	"math"
+)
+
+// a number base with the powers of it that fit in an int
+type radix struct {
+	base int
+	pow  []int
+}
+
+func newRadix(base int) radix {
+	p := []int{1}
+	for p[len(p)-1] <= math.MaxInt/base {
+		p = append(p, p[len(p)-1]*base)
+	}
+	return radix{base: base, pow: p}
+}
+
+// every supported base, 2 to 36 as strconv allows, built once
+var radixes = func() map[int]radix {
+	m := make(map[int]radix)
+	for base := 2; base <= 36; base++ {
+		m[base] = newRadix(base)
+	}
+	return m
+}()
+
+var decimal = radixes[10]
+
+// powers of ten that fit in an int
+var pow10 = decimal.pow
+
+// looks up a supported base
+func radixOf(base int) (radix, error) {
+	rx, ok := radixes[base]
+	if !ok {
+		return radix{}, fmt.Errorf("base %d out of range 2-36", base)
+	}
+	return rx, nil
+}
+
+// number of digits in n > 0
+func (rx radix) digits(n int) int {
+	d := 1
+	for d < len(rx.pow) && n >= rx.pow[d] {
+		d++
+	}
+	return d
+}
+
+// 1 + base^k + ... + base^(k(m-1)), the multiplier turning a k-digit
+// pattern into m repeats of it
+func (rx radix) repunit(k, m int) int {
+	r := 0
+	for range m {
+		r = r*rx.pow[k] + 1
+	}
+	return r
+}
+
+// counts and sums the IDs in [a, b] of length k*m that are a k-digit
+// pattern repeated m times
+func (rx radix) repeats(a, b, k, m int) (count, sum int64) {
+	r := rx.repunit(k, m)
+	lo := a / r
+	if a%r != 0 {
+		lo++ // rounding up as a+r-1 could overflow near MaxInt
+	}
+	lo = max(rx.pow[k-1], lo)
+	hi := min(rx.pow[k]-1, b/r)
+	if lo > hi {
+		return 0, 0
+	}
+
+	// halve whichever factor is even so the series cannot overflow early
+	n, s := int64(hi-lo+1), int64(lo+hi)
+	count = n
+	if n%2 == 0 {
+		n /= 2
+	} else {
+		s /= 2
+	}
+	return count, int64(r) * n * s
+}
+
+// distinct primes dividing n
+func primeFactors(n int) []int {
+	var primes []int
+	for p := 2; p*p <= n; p++ {
+		if n%p == 0 {
+			primes = append(primes, p)
+			for n%p == 0 {
+				n /= p
+			}
+		}
+	}
+	if n > 1 {
+		primes = append(primes, n)
+	}
+	return primes
+}
+
+// counts and sums the IDs in [a, b] of length length that repeat some
+// pattern at least twice. An ID repeating with pattern lengths j and k
+// also repeats with gcd(j, k), so it is enough to include length/q for
+// each prime q dividing length and correct the overlaps
+func (rx radix) anyRepeats(a, b, length int) (count, sum int64) {
+	primes := primeFactors(length)
+	for subset := 1; subset < 1<<len(primes); subset++ {
+		m, bits := 1, 0
+		for i, p := range primes {
+			if subset&(1<<i) != 0 {
+				m *= p
+				bits++
+			}
+		}
+		c, s := rx.repeats(a, b, length/m, m)
+		if bits%2 == 1 {
+			count, sum = count+c, sum+s
+		} else {
+			count, sum = count-c, sum-s
+		}
+	}
+	return count, sum
+}
+
+// the IDs in [a, b] of length length that are two identical halves
+func (rx radix) halves(a, b, length int) (count, sum int64) {
+	if length%2 != 0 {
+		return 0, 0
+	}
+	return rx.repeats(a, b, length/2, 2)
+}
+
+// splits r into runs of equal digit count and totals each with byLength
+func (rx radix) byLength(r IDRange, byLength func(a, b, length int) (int64, int64)) (count, sum int64) {
+	start := max(r.Start, 1)
+	if start > r.End {
+		return 0, 0
+	}
+	for length := rx.digits(start); length <= rx.digits(r.End); length++ {
+		a := max(start, rx.pow[length-1])
+		b := r.End
+		if length < len(rx.pow) {
+			b = min(b, rx.pow[length]-1)
+		}
+		c, s := byLength(a, b, length)
+		count, sum = count+c, sum+s
+	}
+	return count, sum
+}
+
+// sums the IDs in r made of exactly two identical halves (p1)
+func SumRangePart1(r IDRange) int64 {
+	_, sum := decimal.byLength(r, decimal.halves)
+	return sum
+}
+
+// sums the IDs in r made of two or more repeats of a pattern (p2)
+func SumRangePart2(r IDRange) int64 {
+	_, sum := decimal.byLength(r, decimal.anyRepeats)
+	return sum
+}
+
+// closed-form equivalent of SumInvalidIDsInRanges with IsInvalidIDPart1
+func SumInvalidIDsPart1(ranges []IDRange) int64 {
+	var sum int64
+	for _, r := range ranges {
+		sum += SumRangePart1(r)
+	}
+	return sum
+}
+
+// closed-form equivalent of SumInvalidIDsInRanges with IsInvalidIDPart2
+func SumInvalidIDsPart2(ranges []IDRange) int64 {
+	var sum int64
+	for _, r := range ranges {
+		sum += SumRangePart2(r)
+	}
+	return sum
+}
@@ -0,0 +1,82 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 2: Closed-Form Invalid ID Sums
+ *
+ * Tests cross-check the closed-form sums against the brute-force
+ * range scan on random and boundary ranges.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// closed form agrees with the scan on random ranges of every digit length
+func TestClosedFormMatchesScan(t *testing.T) {
+	rng := rand.New(rand.NewPCG(2, 19))
+	for i := 0; i < 2000; i++ {
+		start := rng.IntN(pow10[1+rng.IntN(9)])
+		r := IDRange{start, start + rng.IntN(5000)}
+
+		ranges := []IDRange{r}
+		if got, want := SumRangePart1(r), SumInvalidIDsInRanges(ranges, IsInvalidIDPart1); got != want {
+			t.Errorf("SumRangePart1(%+v) = %d; expected %d", r, got, want)
+		}
+		if got, want := SumRangePart2(r), SumInvalidIDsInRanges(ranges, IsInvalidIDPart2); got != want {
+			t.Errorf("SumRangePart2(%+v) = %d; expected %d", r, got, want)
+		}
+	}
+}
+
+// whole digit lengths, where inclusion-exclusion matters most
+func TestClosedFormFullLengths(t *testing.T) {
+	for length := 1; length <= 6; length++ {
+		r := IDRange{pow10[length-1], pow10[length] - 1}
+		ranges := []IDRange{r}
+		if got, want := SumRangePart1(r), SumInvalidIDsInRanges(ranges, IsInvalidIDPart1); got != want {
+			t.Errorf("SumRangePart1(%+v) = %d; expected %d", r, got, want)
+		}
+		if got, want := SumRangePart2(r), SumInvalidIDsInRanges(ranges, IsInvalidIDPart2); got != want {
+			t.Errorf("SumRangePart2(%+v) = %d; expected %d", r, got, want)
+		}
+	}
+}
+
+// ranges touching zero and the top of int
+func TestClosedFormBounds(t *testing.T) {
+	tests := []IDRange{
+		{0, 0},
+		{0, 11},
+		{math.MaxInt - 5000, math.MaxInt - 1}, // the scan cannot stop at MaxInt
+		{1111111111111111111 - 10, 1111111111111111111 + 10},
+	}
+
+	for _, r := range tests {
+		ranges := []IDRange{r}
+		if got, want := SumRangePart1(r), SumInvalidIDsInRanges(ranges, IsInvalidIDPart1); got != want {
+			t.Errorf("SumRangePart1(%+v) = %d; expected %d", r, got, want)
+		}
+		if got, want := SumRangePart2(r), SumInvalidIDsInRanges(ranges, IsInvalidIDPart2); got != want {
+			t.Errorf("SumRangePart2(%+v) = %d; expected %d", r, got, want)
+		}
+	}
+}
+
+// a range of a trillion IDs needs no scan
+func TestClosedFormHugeRange(t *testing.T) {
+	// p1 invalid IDs below 10^12 are p*(10^k+1) for every k-digit p, k <= 6
+	var expected int64
+	for k := 1; k <= 6; k++ {
+		lo, hi := int64(pow10[k-1]), int64(pow10[k]-1)
+		expected += int64(pow10[k]+1) * (hi - lo + 1) * (lo + hi) / 2
+	}
+
+	if got := SumRangePart1(IDRange{1, pow10[12] - 1}); got != expected {
+		t.Errorf("SumRangePart1(1..10^12) = %d; expected %d", got, expected)
+	}
+}
@@ -0,0 +1,79 @@
+/**
+ * Advent of Code 2025 - Day 2: Overlapping Ranges
+ *
+ * Ranges like 11-22,15-30 overlap, and summing them separately counts
+ * the invalid IDs in the overlap twice. Merging them first, the same
+ * way day 5 counts unique ingredient IDs, gives each ID once.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"cmp"
+	"math/big"
+	"slices"
+)
+
+// merges overlapping and adjacent ranges into sorted disjoint ones
+func MergeRanges(ranges []IDRange) []IDRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b IDRange) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	merged := []IDRange{sorted[0]}
+	for _, current := range sorted[1:] {
+		last := &merged[len(merged)-1]
+
+		// overlapping or adjacent, written so last.End+1 cannot overflow
+		if current.Start <= last.End || current.Start-1 == last.End {
+			last.End = max(last.End, current.End)
+		} else {
+			merged = append(merged, current)
+		}
+	}
+	return merged
+}
+
+// merges overlapping and adjacent big ranges into sorted disjoint ones
+func MergeBigRanges(ranges []BigIDRange) []BigIDRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b BigIDRange) int {
+		return a.Start.Cmp(b.Start)
+	})
+
+	merged := []BigIDRange{sorted[0]}
+	for _, current := range sorted[1:] {
+		last := &merged[len(merged)-1]
+
+		// ends are replaced rather than changed, since inputs share them
+		next := new(big.Int).Add(last.End, bigOne)
+		if current.Start.Cmp(next) <= 0 {
+			if current.End.Cmp(last.End) > 0 {
+				last.End = current.End
+			}
+		} else {
+			merged = append(merged, current)
+		}
+	}
+	return merged
+}
+
+// the same ranges with overlaps merged, so each ID is summed once
+func (rs Ranges) Unique() Ranges {
+	if rs.IsBig() {
+		return Ranges{Big: MergeBigRanges(rs.Big)}
+	}
+	return Ranges{IDs: MergeRanges(rs.IDs)}
+}
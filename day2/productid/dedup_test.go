@@ -0,0 +1,109 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 2: Overlapping Ranges
+ *
+ * Tests verify merging and that unique sums count each ID once.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// merge overlapping, adjacent and contained ranges
+func TestMergeRanges(t *testing.T) {
+	tests := []struct {
+		input    []IDRange
+		expected []IDRange
+	}{
+		{nil, nil},
+		{[]IDRange{{11, 22}, {15, 30}}, []IDRange{{11, 30}}},
+		{[]IDRange{{15, 30}, {11, 22}}, []IDRange{{11, 30}}},   // unsorted
+		{[]IDRange{{1, 10}, {11, 20}}, []IDRange{{1, 20}}},     // adjacent
+		{[]IDRange{{1, 100}, {5, 6}}, []IDRange{{1, 100}}},     // contained
+		{[]IDRange{{1, 5}, {7, 9}}, []IDRange{{1, 5}, {7, 9}}}, // disjoint
+		{[]IDRange{{5, math.MaxInt}, {math.MaxInt, math.MaxInt}}, []IDRange{{5, math.MaxInt}}},
+	}
+
+	for _, test := range tests {
+		got := MergeRanges(test.input)
+		if !slices.Equal(got, test.expected) {
+			t.Errorf("MergeRanges(%v) = %v; expected %v", test.input, got, test.expected)
+		}
+	}
+}
+
+// unique sums count every invalid ID in the union exactly once
+func TestUniqueSumMatchesSet(t *testing.T) {
+	rng := rand.New(rand.NewPCG(2, 24))
+	for i := 0; i < 200; i++ {
+		var ranges []IDRange
+		for range 1 + rng.IntN(6) {
+			start := rng.IntN(5000)
+			ranges = append(ranges, IDRange{start, start + rng.IntN(2000)})
+		}
+
+		seen := make(map[int]bool)
+		var expected int64
+		for _, r := range ranges {
+			for id := r.Start; id <= r.End; id++ {
+				if !seen[id] && IsInvalidIDPart2(id) {
+					expected += int64(id)
+				}
+				seen[id] = true
+			}
+		}
+
+		if got := SumInvalidIDsPart2(MergeRanges(ranges)); got != expected {
+			t.Errorf("unique sum of %v = %d; expected %d", ranges, got, expected)
+		}
+	}
+}
+
+// 22 lies in both 11-22 and 15-30, so only the raw sum counts it twice
+func TestRangesUnique(t *testing.T) {
+	rs, err := ParseRanges(strings.NewReader("11-22,15-30"))
+	if err != nil {
+		t.Fatalf("ParseRanges failed: %v", err)
+	}
+
+	if raw := rs.SumPart1().Int64(); raw != 55 {
+		t.Errorf("raw sum = %d; expected 55", raw)
+	}
+	if unique := rs.Unique().SumPart1().Int64(); unique != 33 {
+		t.Errorf("unique sum = %d; expected 33", unique)
+	}
+}
+
+// big ranges merge the same way and keep their inputs intact
+func TestMergeBigRanges(t *testing.T) {
+	ranges := []BigIDRange{
+		{mustBig("100000000000000000000"), mustBig("100000000000000000050")},
+		{mustBig("1"), mustBig("5")},
+		{mustBig("100000000000000000051"), mustBig("100000000000000000060")},
+		{mustBig("100000000000000000010"), mustBig("100000000000000000020")},
+	}
+
+	merged := MergeBigRanges(ranges)
+	expected := []string{"1-5", "100000000000000000000-100000000000000000060"}
+	if len(merged) != len(expected) {
+		t.Fatalf("MergeBigRanges = %d ranges; expected %d", len(merged), len(expected))
+	}
+	for i, r := range merged {
+		if got := r.Start.String() + "-" + r.End.String(); got != expected[i] {
+			t.Errorf("range %d = %s; expected %s", i, got, expected[i])
+		}
+	}
+
+	if ranges[0].End.Cmp(big.NewInt(0).Add(mustBig("100000000000000000000"), big.NewInt(50))) != 0 {
+		t.Errorf("MergeBigRanges changed its input: %s", ranges[0].End)
+	}
+}
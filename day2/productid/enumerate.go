@@ -0,0 +1,165 @@
+/**
+ * Advent of Code 2025 - Day 2: Invalid ID Enumeration
+ *
+ * Lists the invalid IDs in a range in ascending order, each with the
+ * pattern and repeat count that makes it invalid. IDs are generated
+ * from their patterns like the closed-form sums, so huge ranges cost
+ * only as much as the IDs actually taken from them.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+)
+
+// InvalidID is an invalid ID with the pattern it repeats
+type InvalidID struct {
+	ID      int
+	Pattern string
+	Repeats int
+}
+
+// a run of k-digit patterns lo..hi repeated m times, as ascending IDs
+type repeatStream struct {
+	r, p, hi int
+}
+
+// yields the invalid IDs in r whose length allows the given repeat
+// counts, merging one ascending stream per count and dropping
+// duplicates such as 111111 which several counts produce
+func invalidIDs(r IDRange, counts func(length int) []int, describe func(id int) InvalidID) iter.Seq[InvalidID] {
+	return func(yield func(InvalidID) bool) {
+		start := max(r.Start, 1)
+		if start > r.End {
+			return
+		}
+		for length := decimal.digits(start); length <= decimal.digits(r.End); length++ {
+			a := max(start, pow10[length-1])
+			b := r.End
+			if length < len(pow10) {
+				b = min(b, pow10[length]-1)
+			}
+
+			var streams []repeatStream
+			for _, m := range counts(length) {
+				k := length / m
+				rep := decimal.repunit(k, m)
+				lo := a / rep
+				if a%rep != 0 {
+					lo++
+				}
+				s := repeatStream{r: rep, p: max(pow10[k-1], lo), hi: min(pow10[k]-1, b/rep)}
+				if s.p <= s.hi {
+					streams = append(streams, s)
+				}
+			}
+
+			for {
+				next := -1
+				for _, s := range streams {
+					if s.p <= s.hi && (next < 0 || s.p*s.r < next) {
+						next = s.p * s.r
+					}
+				}
+				if next < 0 {
+					break
+				}
+				for i := range streams {
+					if streams[i].p <= streams[i].hi && streams[i].p*streams[i].r == next {
+						streams[i].p++
+					}
+				}
+				if !yield(describe(next)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// yields the IDs in r made of exactly two identical halves (p1)
+func InvalidIDsPart1(r IDRange) iter.Seq[InvalidID] {
+	counts := func(length int) []int {
+		if length%2 != 0 {
+			return nil
+		}
+		return []int{2}
+	}
+	return invalidIDs(r, counts, func(id int) InvalidID {
+		s := strconv.Itoa(id)
+		return InvalidID{ID: id, Pattern: s[:len(s)/2], Repeats: 2}
+	})
+}
+
+// yields the IDs in r made of two or more repeats of a pattern, each
+// with its shortest pattern (p2)
+func InvalidIDsPart2(r IDRange) iter.Seq[InvalidID] {
+	return invalidIDs(r, primeFactors, func(id int) InvalidID {
+		s := strconv.Itoa(id)
+		k := shortestPeriod(s)
+		return InvalidID{ID: id, Pattern: s[:k], Repeats: len(s) / k}
+	})
+}
+
+// length of the shortest pattern s repeats, len(s) if none
+func shortestPeriod(s string) int {
+	for k := 1; k <= len(s)/2; k++ {
+		if len(s)%k != 0 {
+			continue
+		}
+		match := true
+		for i := k; i < len(s); i++ {
+			if s[i] != s[i-k] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return k
+		}
+	}
+	return len(s)
+}
+
+// prints each range with its invalid ID sum and up to limit of its
+// invalid IDs for the part, noting how many were left out.
+// limit <= 0 lists every ID
+func WriteReport(w io.Writer, ranges []IDRange, part, limit int) error {
+	list, perLength := InvalidIDsPart2, decimal.anyRepeats
+	switch part {
+	case 1:
+		list, perLength = InvalidIDsPart1, decimal.halves
+	case 2:
+	default:
+		return fmt.Errorf("invalid part %d (expected 1 or 2)", part)
+	}
+
+	for _, r := range ranges {
+		count, sum := decimal.byLength(r, perLength)
+		if _, err := fmt.Fprintf(w, "%d-%d: sum %d\n", r.Start, r.End, sum); err != nil {
+			return err
+		}
+
+		listed := 0
+		for id := range list(r) {
+			if limit > 0 && listed == limit {
+				if _, err := fmt.Fprintf(w, "  ... %d more not listed\n", count-int64(listed)); err != nil {
+					return err
+				}
+				break
+			}
+			if _, err := fmt.Fprintf(w, "  %d = %s x%d\n", id.ID, id.Pattern, id.Repeats); err != nil {
+				return err
+			}
+			listed++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 2: Invalid ID Enumeration
+ *
+ * Tests verify enumerated IDs match the scan and explain themselves.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"bytes"
+	"iter"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+// enumeration yields exactly the scanned invalid IDs, in order
+func TestInvalidIDsMatchScan(t *testing.T) {
+	rng := rand.New(rand.NewPCG(2, 20))
+	parts := []struct {
+		list    func(IDRange) iter.Seq[InvalidID]
+		invalid func(int) bool
+	}{
+		{InvalidIDsPart1, IsInvalidIDPart1},
+		{InvalidIDsPart2, IsInvalidIDPart2},
+	}
+
+	for i := 0; i < 500; i++ {
+		start := rng.IntN(pow10[1+rng.IntN(7)])
+		r := IDRange{start, start + rng.IntN(20000)}
+
+		for p, part := range parts {
+			var expected []int
+			for id := r.Start; id <= r.End; id++ {
+				if part.invalid(id) {
+					expected = append(expected, id)
+				}
+			}
+
+			var got []int
+			for id := range part.list(r) {
+				got = append(got, id.ID)
+			}
+
+			if len(got) != len(expected) {
+				t.Fatalf("part %d %+v: %d IDs; expected %d", p+1, r, len(got), len(expected))
+			}
+			for j := range got {
+				if got[j] != expected[j] {
+					t.Fatalf("part %d %+v: ID %d = %d; expected %d", p+1, r, j, got[j], expected[j])
+				}
+			}
+		}
+	}
+}
+
+// patterns are the halves for p1 and the shortest repeat for p2
+func TestInvalidIDPatterns(t *testing.T) {
+	r := IDRange{111111, 111111}
+
+	for id := range InvalidIDsPart1(r) {
+		expected := InvalidID{111111, "111", 2}
+		if id != expected {
+			t.Errorf("InvalidIDsPart1 = %+v; expected %+v", id, expected)
+		}
+	}
+	for id := range InvalidIDsPart2(r) {
+		expected := InvalidID{111111, "1", 6}
+		if id != expected {
+			t.Errorf("InvalidIDsPart2 = %+v; expected %+v", id, expected)
+		}
+	}
+}
+
+// stopping early works on a range too large to scan
+func TestInvalidIDsHugeRange(t *testing.T) {
+	var got []int
+	for id := range InvalidIDsPart2(IDRange{1, pow10[18]}) {
+		got = append(got, id.ID)
+		if len(got) == 10 {
+			break
+		}
+	}
+
+	expected := []int{11, 22, 33, 44, 55, 66, 77, 88, 99, 111}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("ID %d = %d; expected %d", i, got[i], expected[i])
+		}
+	}
+}
+
+// report lists up to the limit and notes the rest
+func TestWriteReport(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, []IDRange{{95, 115}, {1, 100}}, 2, 3); err != nil {
+		t.Fatalf("WriteReport failed: %v", err)
+	}
+
+	expected := "95-115: sum 210\n" +
+		"  99 = 9 x2\n" +
+		"  111 = 1 x3\n" +
+		"1-100: sum 495\n" +
+		"  11 = 1 x2\n" +
+		"  22 = 2 x2\n" +
+		"  33 = 3 x2\n" +
+		"  ... 6 more not listed\n"
+	if buf.String() != expected {
+		t.Errorf("WriteReport =\n%s\nexpected\n%s", buf.String(), expected)
+	}
+
+	if err := WriteReport(&buf, nil, 3, 0); err == nil || !strings.Contains(err.Error(), "invalid part") {
+		t.Errorf("WriteReport(part 3) error = %v; expected invalid part", err)
+	}
+}
@@ -0,0 +1,145 @@
+/**
+ * Advent of Code 2025 - Day 2: Parallel Range Scans
+ *
+ * Rules without a closed form have to check every ID, so the scan is
+ * split into chunks of at most ChunkSize IDs and spread over a pool
+ * of workers. Partial sums are added in chunk order however the
+ * workers finish, so the result never depends on scheduling. The
+ * scan stops early when its context is cancelled.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"context"
+	"iter"
+	"runtime"
+	"sync"
+)
+
+// DefaultChunkSize is the most IDs one task scans when Pool.ChunkSize is unset
+const DefaultChunkSize = 1 << 16
+
+// IDs scanned between context checks within a chunk
+const checkEvery = 1 << 12
+
+// Pool configures a parallel scan
+type Pool struct {
+	Workers   int // goroutines, runtime.GOMAXPROCS(0) if <= 0
+	ChunkSize int // most IDs per task, DefaultChunkSize if <= 0
+}
+
+// yields consecutive chunks of at most size IDs covering ranges, in
+// order. Chunks are made as they are taken, so huge ranges cost nothing
+func Chunks(ranges []IDRange, size int) iter.Seq[IDRange] {
+	if size <= 0 {
+		size = DefaultChunkSize
+	}
+
+	return func(yield func(IDRange) bool) {
+		for _, r := range ranges {
+			for start := r.Start; ; start += size {
+				// compared as a difference so ranges ending near MaxInt cannot overflow
+				if r.End-start < size {
+					if !yield(IDRange{start, r.End}) {
+						return
+					}
+					break
+				}
+				if !yield(IDRange{start, start + size - 1}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// a chunk and its position among all chunks
+type chunkTask struct {
+	index int
+	r     IDRange
+}
+
+// a chunk's partial sum
+type chunkSum struct {
+	index int
+	sum   int64
+}
+
+// sums the IDs in ranges that isInvalid reports, like
+// SumInvalidIDsInRanges but across the pool's workers. isInvalid is
+// called concurrently. Returns ctx.Err() if ctx ends first
+func (p Pool) Sum(ctx context.Context, ranges []IDRange, isInvalid func(int) bool) (int64, error) {
+	workers := p.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	tasks := make(chan chunkTask)
+	go func() {
+		defer close(tasks)
+		i := 0
+		for r := range Chunks(ranges, p.ChunkSize) {
+			select {
+			case tasks <- chunkTask{i, r}:
+				i++
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	results := make(chan chunkSum)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t := range tasks {
+				results <- chunkSum{t.index, scanChunk(ctx, t.r, isInvalid)}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// partial sums are added in chunk order whatever order they finish in
+	pending := make(map[int]int64)
+	next := 0
+	var sum int64
+	for res := range results {
+		pending[res.index] = res.sum
+		for s, ok := pending[next]; ok; s, ok = pending[next] {
+			sum += s
+			delete(pending, next)
+			next++
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return sum, nil
+}
+
+// scans one chunk, giving up between batches once ctx is done
+func scanChunk(ctx context.Context, r IDRange, isInvalid func(int) bool) int64 {
+	var sum int64
+	for id := r.Start; ; id++ {
+		if (id-r.Start)%checkEvery == 0 && ctx.Err() != nil {
+			return 0
+		}
+		if isInvalid(id) {
+			sum += int64(id)
+		}
+		// stopping on equality lets a chunk end at MaxInt
+		if id == r.End {
+			return sum
+		}
+	}
+}
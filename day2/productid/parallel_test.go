@@ -0,0 +1,118 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 2: Parallel Range Scans
+ *
+ * Tests verify parallel sums match the sequential scan for any pool
+ * shape and stop on cancellation. Run with -race.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// chunks cover every range exactly, in order
+func TestChunks(t *testing.T) {
+	chunks := slices.Collect(Chunks([]IDRange{{1, 10}, {20, 20}, {30, 34}}, 4))
+	expected := []IDRange{{1, 4}, {5, 8}, {9, 10}, {20, 20}, {30, 33}, {34, 34}}
+
+	if len(chunks) != len(expected) {
+		t.Fatalf("Chunks = %v; expected %v", chunks, expected)
+	}
+	for i := range expected {
+		if chunks[i] != expected[i] {
+			t.Errorf("chunk %d = %+v; expected %+v", i, chunks[i], expected[i])
+		}
+	}
+}
+
+// a range ending at MaxInt chunks and scans without overflowing
+func TestChunksMaxInt(t *testing.T) {
+	r := IDRange{math.MaxInt - 9, math.MaxInt}
+	chunks := slices.Collect(Chunks([]IDRange{r}, 4))
+	if last := chunks[len(chunks)-1]; len(chunks) != 3 || last.End != math.MaxInt {
+		t.Fatalf("Chunks(%+v) = %v; expected 3 chunks ending at MaxInt", r, chunks)
+	}
+
+	var seen atomic.Int64
+	_, err := Pool{Workers: 2, ChunkSize: 4}.Sum(context.Background(), []IDRange{r}, func(int) bool {
+		seen.Add(1)
+		return false
+	})
+	if err != nil || seen.Load() != 10 {
+		t.Errorf("Sum checked %d IDs, error %v; expected 10 and no error", seen.Load(), err)
+	}
+}
+
+// any pool shape gives the sequential sum
+func TestPoolSumMatchesScan(t *testing.T) {
+	rng := rand.New(rand.NewPCG(2, 23))
+	var ranges []IDRange
+	for range 30 {
+		start := rng.IntN(pow10[1+rng.IntN(7)])
+		ranges = append(ranges, IDRange{start, start + rng.IntN(30000)})
+	}
+	expected := SumInvalidIDsInRanges(ranges, IsInvalidIDPart2)
+
+	for _, pool := range []Pool{{}, {Workers: 1}, {Workers: 3, ChunkSize: 1}, {Workers: 8, ChunkSize: 777}, {Workers: 100}} {
+		sum, err := pool.Sum(context.Background(), ranges, IsInvalidIDPart2)
+		if err != nil {
+			t.Errorf("%+v.Sum unexpected error: %v", pool, err)
+		}
+		if sum != expected {
+			t.Errorf("%+v.Sum = %d; expected %d", pool, sum, expected)
+		}
+	}
+}
+
+// no ranges, no work
+func TestPoolSumEmpty(t *testing.T) {
+	sum, err := Pool{}.Sum(context.Background(), nil, IsInvalidIDPart1)
+	if sum != 0 || err != nil {
+		t.Errorf("Sum(nil) = %d, %v; expected 0, nil", sum, err)
+	}
+}
+
+// a cancelled context stops the scan part way
+func TestPoolSumCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var checked atomic.Int64
+	slow := func(id int) bool {
+		if checked.Add(1) == 1000 {
+			cancel()
+		}
+		return IsInvalidIDPart2(id)
+	}
+
+	ranges := []IDRange{{1, 100_000_000}}
+	_, err := Pool{Workers: 4, ChunkSize: 10_000}.Sum(ctx, ranges, slow)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Sum error = %v; expected context.Canceled", err)
+	}
+	if n := checked.Load(); n > 1_000_000 {
+		t.Errorf("Sum checked %d IDs after cancelling; expected it to stop early", n)
+	}
+}
+
+// a deadline surfaces as context.DeadlineExceeded
+func TestPoolSumTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := Pool{Workers: 2}.Sum(ctx, []IDRange{{1, math.MaxInt - 1}}, IsInvalidIDPart1)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Sum error = %v; expected context.DeadlineExceeded", err)
+	}
+}
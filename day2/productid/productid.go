@@ -0,0 +1,191 @@
+/**
+ * Advent of Code 2025 - Day 2: Invalid Product IDs
+ *
+ * This package identifies invalid product IDs in given ranges.
+ * An ID is invalid if it consists of a repeated digit pattern.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// IDRange represents a range of product IDs from Start to End inclusive
+type IDRange struct {
+	Start, End int
+}
+
+// converts a string like "11-22" into a struct
+func ParseIDRange(rangeStr string) (IDRange, error) {
+	parts := strings.Split(rangeStr, "-")
+	if len(parts) != 2 {
+		return IDRange{}, fmt.Errorf("invalid range format: %s", rangeStr)
+	}
+
+	start, err1 := strconv.Atoi(strings.TrimSpace(parts[0]))
+	end, err2 := strconv.Atoi(strings.TrimSpace(parts[1]))
+
+	// wrapped so callers can spot strconv.ErrRange and switch to big IDs
+	if err := cmp.Or(err1, err2); err != nil {
+		return IDRange{}, fmt.Errorf("invalid numbers in range: %s: %w", rangeStr, err)
+	}
+
+	if start > end {
+		return IDRange{}, fmt.Errorf("start > end in range: %s", rangeStr)
+	}
+
+	return IDRange{Start: start, End: end}, nil
+}
+
+// ParseIDRanges converts a comma-separated line of ranges into a slice of IDRange
+func ParseIDRanges(line string) ([]IDRange, error) {
+	return parseRangeList(line, ParseIDRange)
+}
+
+// splits a comma-separated line of ranges and parses each with parse
+func parseRangeList[R any](line string, parse func(string) (R, error)) ([]R, error) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return nil, nil
+	}
+
+	rangeStrings := strings.Split(line, ",")
+	var ranges []R
+
+	for _, rangeStr := range rangeStrings {
+		rangeStr = strings.TrimSpace(rangeStr)
+		if rangeStr == "" {
+			continue
+		}
+
+		idRange, err := parse(rangeStr)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, idRange)
+	}
+
+	return ranges, nil
+}
+
+// checks if an ID is invalid for p1
+// an ID is invalid if it consists of exactly two identical halves
+func IsInvalidIDPart1(id int) bool {
+	s := strconv.Itoa(id)
+	length := len(s)
+
+	// Must be even length to be split into two equal halves
+	if length%2 != 0 {
+		return false
+	}
+
+	half := length / 2
+	firstHalf := s[:half]
+	secondHalf := s[half:]
+
+	return firstHalf == secondHalf
+}
+
+// checks if an ID is invalid for p2
+// an ID is invalid if it consists of two or more repetitions of the same digit pattern
+func IsInvalidIDPart2(id int) bool {
+	s := strconv.Itoa(id)
+	length := len(s)
+
+	// Try all possible pattern lengths that divide the total length
+	for patternLen := 1; patternLen <= length/2; patternLen++ {
+		if length%patternLen != 0 {
+			continue
+		}
+
+		pattern := s[:patternLen]
+		repeatCount := length / patternLen
+
+		// Must repeat at least twice
+		if repeatCount < 2 {
+			continue
+		}
+
+		// Check if the entire string matches this repeated pattern
+		allMatch := true
+		for i := 0; i < length; i += patternLen {
+			if s[i:i+patternLen] != pattern {
+				allMatch = false
+				break
+			}
+		}
+
+		if allMatch {
+			return true
+		}
+	}
+
+	return false
+}
+
+// calculates the sum of all invalid IDs within the given ranges
+func SumInvalidIDsInRanges(ranges []IDRange, isInvalidFunc func(int) bool) int64 {
+	var sum int64
+
+	for _, idRange := range ranges {
+		for id := idRange.Start; id <= idRange.End; id++ {
+			if isInvalidFunc(id) {
+				sum += int64(id)
+			}
+		}
+	}
+
+	return sum
+}
+
+// reads the input file and processes all ID ranges, see Parse
+func ReadAndProcessRanges(filename string) ([]IDRange, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+// parses every comma-separated range line from any reader, skipping blank lines
+func Parse(r io.Reader) ([]IDRange, error) {
+	return parseRangeLines(r, ParseIDRange)
+}
+
+// parses every comma-separated range line with parse
+func parseRangeLines[R any](r io.Reader, parse func(string) (R, error)) ([]R, error) {
+	var allRanges []R
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		ranges, err := parseRangeList(line, parse)
+		if err != nil {
+			return nil, err
+		}
+
+		allRanges = append(allRanges, ranges...)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return allRanges, nil
+}
@@ -7,10 +7,13 @@
  * Email: KleaSCM@gmail.com
  */
 
-package main
+package productid
 
 import (
+	"errors"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -204,25 +207,13 @@ func TestLargeNumbers(t *testing.T) {
 	}
 }
 
-// read ranges
-func TestReadAndProcessRanges(t *testing.T) {
-	// Create a temporary file with test data
+// parse ranges from reader
+func TestParse(t *testing.T) {
 	content := "11-22,95-115\n998-1012\n"
 
-	tmpFile, err := os.CreateTemp("", "test_ranges_*.txt")
+	ranges, err := Parse(strings.NewReader(content))
 	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
-	}
-	defer os.Remove(tmpFile.Name())
-
-	if _, err := tmpFile.WriteString(content); err != nil {
-		t.Fatalf("Failed to write to temp file: %v", err)
-	}
-	tmpFile.Close()
-
-	ranges, err := ReadAndProcessRanges(tmpFile.Name())
-	if err != nil {
-		t.Fatalf("ReadAndProcessRanges failed: %v", err)
+		t.Fatalf("Parse failed: %v", err)
 	}
 
 	expected := []IDRange{
@@ -240,3 +231,23 @@ func TestReadAndProcessRanges(t *testing.T) {
 		}
 	}
 }
+
+// file wrapper
+func TestReadAndProcessRanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ranges.txt")
+	if err := os.WriteFile(path, []byte("11-22,95-115\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ranges, err := ReadAndProcessRanges(path)
+	if err != nil {
+		t.Fatalf("ReadAndProcessRanges failed: %v", err)
+	}
+	if len(ranges) != 2 || ranges[1] != (IDRange{95, 115}) {
+		t.Errorf("ReadAndProcessRanges = %+v; expected [{11 22} {95 115}]", ranges)
+	}
+
+	if _, err := ReadAndProcessRanges("does_not_exist.txt"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadAndProcessRanges(missing) error = %v; expected os.ErrNotExist", err)
+	}
+}
@@ -0,0 +1,171 @@
+/**
+ * Advent of Code 2025 - Day 2: Invalid IDs in Any Base
+ *
+ * The puzzle's rules look at decimal digits, but nothing about them
+ * is decimal: 0b1010 is two halves in binary just as 1212 is in base
+ * ten. Detection, the closed-form sums and range parsing here take a
+ * base from 2 to 36, so invalid ID density can be compared across
+ * bases on the same ranges.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// checks if an ID written in base is two identical halves (p1)
+func IsInvalidIDPart1Base(id, base int) bool {
+	s := format(id, base)
+	return len(s)%2 == 0 && repeatsExactly(s, 2)
+}
+
+// checks if an ID written in base is two or more repeats of a pattern (p2)
+func IsInvalidIDPart2Base(id, base int) bool {
+	s := format(id, base)
+	return shortestPeriod(s) < len(s)
+}
+
+// totals ranges with a per-length closed form in base, refusing bases
+// strconv cannot write; the sum is only meaningful when sumsFitInt64
+func totalBase(ranges []IDRange, base int, perLength func(rx radix, a, b, length int) (int64, int64)) (count, sum int64, err error) {
+	rx, err := radixOf(base)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	byLength := func(a, b, length int) (int64, int64) { return perLength(rx, a, b, length) }
+	for _, r := range ranges {
+		c, s := rx.byLength(r, byLength)
+		count, sum = count+c, sum+s
+	}
+	return count, sum, nil
+}
+
+// sums ranges like totalBase, also refusing ranges whose sums could
+// overflow int64
+func sumBase(ranges []IDRange, base int, perLength func(rx radix, a, b, length int) (int64, int64)) (int64, error) {
+	if _, err := radixOf(base); err != nil {
+		return 0, err
+	}
+	if !sumsFitInt64(ranges) {
+		return 0, fmt.Errorf("ranges too large to sum in 64 bits outside base 10")
+	}
+	_, sum, err := totalBase(ranges, base, perLength)
+	return sum, err
+}
+
+// sums the IDs in ranges that are two identical halves in base (p1)
+func SumInvalidIDsPart1Base(ranges []IDRange, base int) (int64, error) {
+	return sumBase(ranges, base, radix.halves)
+}
+
+// sums the IDs in ranges that repeat a pattern in base (p2)
+func SumInvalidIDsPart2Base(ranges []IDRange, base int) (int64, error) {
+	return sumBase(ranges, base, radix.anyRepeats)
+}
+
+// counts the IDs in ranges that are invalid in base, for each part;
+// only the sums can overflow, so any range is accepted
+func CountInvalidIDsBase(ranges []IDRange, base int) (part1, part2 int64, err error) {
+	if part1, _, err = totalBase(ranges, base, radix.halves); err != nil {
+		return 0, 0, err
+	}
+	part2, _, err = totalBase(ranges, base, radix.anyRepeats)
+	return part1, part2, err
+}
+
+// prints how many IDs in ranges are invalid for each part in each base,
+// as a count and a share of every ID in the ranges
+func WriteDensity(w io.Writer, ranges []IDRange, bases []int) error {
+	total := 0.0
+	for _, r := range ranges {
+		total += float64(r.End) - float64(r.Start) + 1
+	}
+	share := func(n int64) float64 {
+		if total == 0 {
+			return 0
+		}
+		return 100 * float64(n) / total
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "base\tp1\tp1 %\tp2\tp2 %\t")
+	for _, base := range bases {
+		part1, part2, err := CountInvalidIDsBase(ranges, base)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%.6f\t%d\t%.6f\t\n", base, part1, share(part1), part2, share(part2))
+	}
+	return tw.Flush()
+}
+
+// converts a range written in base, like "1a-2f" or "0x1a-0x2f" in
+// base 16, into an IDRange
+func ParseIDRangeBase(rangeStr string, base int) (IDRange, error) {
+	if _, err := radixOf(base); err != nil {
+		return IDRange{}, err
+	}
+
+	parts := strings.Split(rangeStr, "-")
+	if len(parts) != 2 {
+		return IDRange{}, fmt.Errorf("invalid range format: %s", rangeStr)
+	}
+
+	start, err1 := strconv.ParseInt(trimBasePrefix(parts[0], base), base, 0)
+	end, err2 := strconv.ParseInt(trimBasePrefix(parts[1], base), base, 0)
+
+	if err1 != nil || err2 != nil {
+		return IDRange{}, fmt.Errorf("invalid base %d numbers in range: %s", base, rangeStr)
+	}
+
+	if start > end {
+		return IDRange{}, fmt.Errorf("start > end in range: %s", rangeStr)
+	}
+
+	return IDRange{Start: int(start), End: int(end)}, nil
+}
+
+// drops the Go literal prefix for base (0x, 0o or 0b) if present
+func trimBasePrefix(s string, base int) string {
+	s = strings.TrimSpace(s)
+	prefix := map[int]string{2: "0b", 8: "0o", 16: "0x"}[base]
+	if prefix != "" && len(s) > len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) {
+		return s[len(prefix):]
+	}
+	return s
+}
+
+// parses every comma-separated range line from any reader, with IDs
+// written in base
+func ParseBase(r io.Reader, base int) ([]IDRange, error) {
+	return parseRangeLines(r, func(s string) (IDRange, error) {
+		return ParseIDRangeBase(s, base)
+	})
+}
+
+// parses ranges written in base like ParseRanges, widening them to
+// BigIDRanges when a sum could overflow int64; IDs must still fit in
+// 64 bits
+func ParseRangesBase(r io.Reader, base int) (Ranges, error) {
+	if base == 10 {
+		return ParseRanges(r)
+	}
+
+	ranges, err := ParseBase(r, base)
+	if err != nil {
+		return Ranges{}, err
+	}
+	if !sumsFitInt64(ranges) {
+		return Ranges{Big: ToBig(ranges)}, nil
+	}
+	return Ranges{IDs: ranges}, nil
+}
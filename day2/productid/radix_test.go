@@ -0,0 +1,237 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 2: Invalid IDs in Any Base
+ *
+ * Tests cross-check base-N sums against a scan in that base and
+ * verify parsing ranges written in other bases.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"bytes"
+	"math"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+// detection in other bases
+func TestIsInvalidIDBase(t *testing.T) {
+	tests := []struct {
+		id, base     int
+		part1, part2 bool
+	}{
+		{0b1010, 2, true, true},
+		{0b10101, 2, false, false},
+		{0b101010, 2, false, true},
+		{0xabab, 16, true, true},
+		{0xabc, 16, false, false},
+		{1212, 10, true, true},
+		{35*36 + 35, 36, true, true}, // "zz"
+		{7, 2, false, true},          // "111"
+	}
+
+	for _, test := range tests {
+		if got := IsInvalidIDPart1Base(test.id, test.base); got != test.part1 {
+			t.Errorf("IsInvalidIDPart1Base(%d, %d) = %v; expected %v", test.id, test.base, got, test.part1)
+		}
+		if got := IsInvalidIDPart2Base(test.id, test.base); got != test.part2 {
+			t.Errorf("IsInvalidIDPart2Base(%d, %d) = %v; expected %v", test.id, test.base, got, test.part2)
+		}
+	}
+}
+
+// closed form in every base agrees with a scan in that base
+func TestSumBaseMatchesScan(t *testing.T) {
+	rng := rand.New(rand.NewPCG(2, 25))
+	for i := 0; i < 1000; i++ {
+		base := 2 + rng.IntN(35)
+		start := rng.IntN(pow10[1+rng.IntN(9)])
+		ranges := []IDRange{{start, start + rng.IntN(3000)}}
+		if i%50 == 0 {
+			ranges = []IDRange{{math.MaxInt - 3000, math.MaxInt - 1}}
+		}
+
+		var count1, count2, sum1, sum2 int64
+		for id := ranges[0].Start; id <= ranges[0].End; id++ {
+			if IsInvalidIDPart1Base(id, base) {
+				count1++
+				sum1 += int64(id)
+			}
+			if IsInvalidIDPart2Base(id, base) {
+				count2++
+				sum2 += int64(id)
+			}
+		}
+
+		// sums near MaxInt overflow int64, so those ranges check counts only
+		if ranges[0].End < math.MaxInt/2 {
+			if got, err := SumInvalidIDsPart1Base(ranges, base); err != nil || got != sum1 {
+				t.Errorf("SumInvalidIDsPart1Base(%v, %d) = %d, %v; expected %d", ranges, base, got, err, sum1)
+			}
+			if got, err := SumInvalidIDsPart2Base(ranges, base); err != nil || got != sum2 {
+				t.Errorf("SumInvalidIDsPart2Base(%v, %d) = %d, %v; expected %d", ranges, base, got, err, sum2)
+			}
+			if got1, got2, err := CountInvalidIDsBase(ranges, base); err != nil || got1 != count1 || got2 != count2 {
+				t.Errorf("CountInvalidIDsBase(%v, %d) = %d, %d, %v; expected %d, %d", ranges, base, got1, got2, err, count1, count2)
+			}
+			continue
+		}
+
+		rx := radixes[base]
+		if got, _ := rx.byLength(ranges[0], rx.halves); got != count1 {
+			t.Errorf("p1 count near MaxInt in base %d = %d; expected %d", base, got, count1)
+		}
+		if got, _ := rx.byLength(ranges[0], rx.anyRepeats); got != count2 {
+			t.Errorf("p2 count near MaxInt in base %d = %d; expected %d", base, got, count2)
+		}
+	}
+}
+
+// base 10 is the puzzle itself
+func TestSumBaseDecimal(t *testing.T) {
+	ranges := []IDRange{{11, 22}, {95, 115}, {998, 1012}, {1188511880, 1188511890}}
+	if got, _ := SumInvalidIDsPart1Base(ranges, 10); got != SumInvalidIDsPart1(ranges) {
+		t.Errorf("SumInvalidIDsPart1Base(10) = %d; expected %d", got, SumInvalidIDsPart1(ranges))
+	}
+	if got, _ := SumInvalidIDsPart2Base(ranges, 10); got != SumInvalidIDsPart2(ranges) {
+		t.Errorf("SumInvalidIDsPart2Base(10) = %d; expected %d", got, SumInvalidIDsPart2(ranges))
+	}
+}
+
+// unsupported bases and overflowing ranges are refused
+func TestSumBaseErrors(t *testing.T) {
+	for _, base := range []int{0, 1, 37} {
+		if _, err := SumInvalidIDsPart1Base([]IDRange{{1, 10}}, base); err == nil {
+			t.Errorf("SumInvalidIDsPart1Base(base %d) expected error but got none", base)
+		}
+	}
+	if _, err := SumInvalidIDsPart2Base([]IDRange{{1, math.MaxInt - 1}}, 2); err == nil {
+		t.Errorf("SumInvalidIDsPart2Base(1..MaxInt) expected error but got none")
+	}
+}
+
+// ranges written in other bases, with or without prefixes
+func TestParseIDRangeBase(t *testing.T) {
+	tests := []struct {
+		input    string
+		base     int
+		expected IDRange
+		hasError bool
+	}{
+		{"1a-2F", 16, IDRange{0x1a, 0x2f}, false},
+		{"0x1a-0X2f", 16, IDRange{0x1a, 0x2f}, false},
+		{"0b101-111", 2, IDRange{5, 7}, false},
+		{"0o17-20", 8, IDRange{15, 16}, false},
+		{"zz-100", 36, IDRange{1295, 1296}, false},
+		{"11-22", 10, IDRange{11, 22}, false},
+		{"g-1", 16, IDRange{}, true},   // not a hex digit
+		{"2-3", 2, IDRange{}, true},    // not binary
+		{"0x-1", 16, IDRange{}, true},  // prefix alone
+		{"ff-1", 16, IDRange{}, true},  // start > end
+		{"1-2-3", 16, IDRange{}, true}, // too many parts
+		{"1-2", 37, IDRange{}, true},   // base out of range
+	}
+
+	for _, test := range tests {
+		result, err := ParseIDRangeBase(test.input, test.base)
+		if test.hasError {
+			if err == nil {
+				t.Errorf("ParseIDRangeBase(%q, %d) expected error but got none", test.input, test.base)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseIDRangeBase(%q, %d) unexpected error: %v", test.input, test.base, err)
+		}
+		if result != test.expected {
+			t.Errorf("ParseIDRangeBase(%q, %d) = %+v; expected %+v", test.input, test.base, result, test.expected)
+		}
+	}
+}
+
+// hex input parses line by line like decimal input
+func TestParseBase(t *testing.T) {
+	ranges, err := ParseBase(strings.NewReader("0xb-0x16,5f-73\n\n3e6-3f4\n"), 16)
+	if err != nil {
+		t.Fatalf("ParseBase failed: %v", err)
+	}
+
+	expected := []IDRange{{11, 22}, {95, 115}, {998, 1012}}
+	if len(ranges) != len(expected) {
+		t.Fatalf("ParseBase = %v; expected %v", ranges, expected)
+	}
+	for i := range expected {
+		if ranges[i] != expected[i] {
+			t.Errorf("range %d = %+v; expected %+v", i, ranges[i], expected[i])
+		}
+	}
+}
+
+// hex ranges whose sums overflow int64 come back as big ranges
+func TestParseRangesBase(t *testing.T) {
+	tests := []struct {
+		input, decimal string
+		big            bool
+	}{
+		{"b-16,5f-73", "11-22,95-115", false},
+		{"0-7fffffffffffffff", "0-9223372036854775807", true},
+	}
+
+	for _, test := range tests {
+		rs, err := ParseRangesBase(strings.NewReader(test.input), 16)
+		if err != nil {
+			t.Errorf("ParseRangesBase(%q) unexpected error: %v", test.input, err)
+			continue
+		}
+		if rs.IsBig() != test.big {
+			t.Errorf("ParseRangesBase(%q).IsBig() = %v; expected %v", test.input, rs.IsBig(), test.big)
+		}
+
+		decimal, err := ParseRanges(strings.NewReader(test.decimal))
+		if err != nil {
+			t.Fatalf("ParseRanges(%q) failed: %v", test.decimal, err)
+		}
+		if got, expected := rs.SumPart2(), decimal.SumPart2(); got.Cmp(expected) != 0 {
+			t.Errorf("ParseRangesBase(%q).SumPart2() = %s; expected %s", test.input, got, expected)
+		}
+	}
+}
+
+// density rows per base
+func TestWriteDensity(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteDensity(&buf, []IDRange{{1, 100}}, []int{2, 10}); err != nil {
+		t.Fatalf("WriteDensity failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("WriteDensity has %d lines; expected 3:\n%s", len(lines), buf.String())
+	}
+	// 11, 22, ... 99 in base 10, for both parts
+	if fields := strings.Fields(lines[2]); strings.Join(fields, " ") != "10 9 9.000000 9 9.000000" {
+		t.Errorf("base 10 row = %q; expected 10 9 9.000000 9 9.000000", lines[2])
+	}
+
+	// counts work on ranges far too large to sum
+	huge := []IDRange{{1, 999_999_999_999_999_999}}
+	part1, part2, err := CountInvalidIDsBase(huge, 10)
+	if err != nil {
+		t.Fatalf("CountInvalidIDsBase(1..10^18-1) failed: %v", err)
+	}
+	// 9 * 10^(k-1) doubled k-digit halves for k up to 9
+	if part1 != 999_999_999 || part2 < part1 {
+		t.Errorf("CountInvalidIDsBase(1..10^18-1) = %d, %d; expected 999999999 and at least as many", part1, part2)
+	}
+	if err := WriteDensity(&buf, huge, []int{2, 10, 36}); err != nil {
+		t.Errorf("WriteDensity(1..10^18-1) failed: %v", err)
+	}
+
+	if err := WriteDensity(&buf, []IDRange{{1, 100}}, []int{40}); err == nil {
+		t.Errorf("WriteDensity(base 40) expected error but got none")
+	}
+}
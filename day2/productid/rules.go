@@ -0,0 +1,331 @@
+/**
+ * Advent of Code 2025 - Day 2: Invalidity Rules
+ *
+ * A small rule language for exploring variants of the puzzle without
+ * writing Go. A rule is a name with optional arguments, and rules
+ * compose: "and(palindrome, not(part2))", "base(2, repeats(3))",
+ * "digitsum(%7)". Rules look at an ID's digits, in base 10 unless a
+ * base(...) around them says otherwise.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Rule reports whether an ID is invalid, usable with SumInvalidIDsInRanges
+type Rule func(id int) bool
+
+// a rule over the digits of id written in base
+type check func(id, base int) bool
+
+// a parsed rule expression: a name or number with optional arguments
+type node struct {
+	name string
+	args []node
+}
+
+// how to build a check from a rule's arguments
+type ruleBuilder struct {
+	usage string
+	help  string
+	build func(args []node) (check, error)
+}
+
+var ruleBuilders map[string]ruleBuilder
+
+func init() {
+	ruleBuilders = map[string]ruleBuilder{
+		"part1": {"part1", "exactly two identical halves", noArgs(IsInvalidIDPart1Base)},
+		"part2": {"part2", "two or more repeats of a pattern", noArgs(IsInvalidIDPart2Base)},
+		"palindrome": {"palindrome", "reads the same backwards", noArgs(func(id, base int) bool {
+			s := format(id, base)
+			for i := 0; i < len(s)/2; i++ {
+				if s[i] != s[len(s)-1-i] {
+					return false
+				}
+			}
+			return true
+		})},
+		"repeats":  {"repeats(n)", "exactly n copies of a pattern", buildRepeats},
+		"digitsum": {"digitsum(=n|<n|>n|%n)", "digit sum equal to, below, above or a multiple of n", buildDigitSum},
+		"base":     {"base(b, rule)", "rule applied to the ID written in base b (2-36)", buildBase},
+		"and":      {"and(rule, ...)", "every rule holds", buildAnd},
+		"or":       {"or(rule, ...)", "any rule holds", buildOr},
+		"not":      {"not(rule)", "rule does not hold", buildNot},
+	}
+}
+
+// compiles a rule expression such as "and(part2, not(palindrome))"
+func ParseRule(expr string) (Rule, error) {
+	p := &ruleParser{s: expr}
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.s) {
+		return nil, fmt.Errorf("rule %q: unexpected %q at offset %d", expr, p.s[p.pos:], p.pos)
+	}
+
+	c, err := compile(n)
+	if err != nil {
+		return nil, fmt.Errorf("rule %q: %w", expr, err)
+	}
+	return func(id int) bool { return c(id, 10) }, nil
+}
+
+// lists every rule with its usage, for command help
+func WriteRules(w io.Writer) error {
+	names := make([]string, 0, len(ruleBuilders))
+	for name := range ruleBuilders {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		b := ruleBuilders[name]
+		if _, err := fmt.Fprintf(w, "  %-24s %s\n", b.usage, b.help); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func compile(n node) (check, error) {
+	b, ok := ruleBuilders[n.name]
+	if !ok {
+		return nil, fmt.Errorf("unknown rule %q", n.name)
+	}
+	c, err := b.build(n.args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", b.usage, err)
+	}
+	return c, nil
+}
+
+// digits of id in base, as strconv writes them
+func format(id, base int) string {
+	return strconv.FormatInt(int64(id), base)
+}
+
+// reports whether s is exactly n copies of one pattern
+func repeatsExactly(s string, n int) bool {
+	if len(s)%n != 0 {
+		return false
+	}
+	k := len(s) / n
+	for i := k; i < len(s); i++ {
+		if s[i] != s[i-k] {
+			return false
+		}
+	}
+	return true
+}
+
+func noArgs(c check) func([]node) (check, error) {
+	return func(args []node) (check, error) {
+		if len(args) != 0 {
+			return nil, fmt.Errorf("takes no arguments")
+		}
+		return c, nil
+	}
+}
+
+// argument that must be a plain number
+func number(n node) (int, error) {
+	v, err := strconv.Atoi(n.name)
+	if err != nil || len(n.args) != 0 {
+		return 0, fmt.Errorf("expected a number, got %q", n.name)
+	}
+	return v, nil
+}
+
+func buildRepeats(args []node) (check, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("takes one argument")
+	}
+	n, err := number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("repeat count %d must be positive", n)
+	}
+	return func(id, base int) bool {
+		return repeatsExactly(format(id, base), n)
+	}, nil
+}
+
+func buildDigitSum(args []node) (check, error) {
+	if len(args) != 1 || args[0].name == "" {
+		return nil, fmt.Errorf("takes one argument")
+	}
+	op, text := args[0].name[0], args[0].name[1:]
+	if !strings.ContainsRune("=<>%", rune(op)) {
+		return nil, fmt.Errorf("expected =, <, > or %% before %q", args[0].name)
+	}
+	n, err := number(node{name: text})
+	if err != nil {
+		return nil, err
+	}
+
+	var cmp func(sum int) bool
+	switch op {
+	case '=':
+		cmp = func(sum int) bool { return sum == n }
+	case '<':
+		cmp = func(sum int) bool { return sum < n }
+	case '>':
+		cmp = func(sum int) bool { return sum > n }
+	case '%':
+		if n == 0 {
+			return nil, fmt.Errorf("cannot take multiples of 0")
+		}
+		cmp = func(sum int) bool { return sum%n == 0 }
+	}
+
+	return func(id, base int) bool {
+		sum := 0
+		for id > 0 {
+			sum += id % base
+			id /= base
+		}
+		return cmp(sum)
+	}, nil
+}
+
+func buildBase(args []node) (check, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("takes a base and a rule")
+	}
+	b, err := number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	if b < 2 || b > 36 {
+		return nil, fmt.Errorf("base %d out of range 2-36", b)
+	}
+	c, err := compile(args[1])
+	if err != nil {
+		return nil, err
+	}
+	return func(id, _ int) bool { return c(id, b) }, nil
+}
+
+// compiles every argument as a rule
+func compileAll(args []node) ([]check, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("takes at least one rule")
+	}
+	checks := make([]check, len(args))
+	for i, a := range args {
+		c, err := compile(a)
+		if err != nil {
+			return nil, err
+		}
+		checks[i] = c
+	}
+	return checks, nil
+}
+
+func buildAnd(args []node) (check, error) {
+	checks, err := compileAll(args)
+	if err != nil {
+		return nil, err
+	}
+	return func(id, base int) bool {
+		for _, c := range checks {
+			if !c(id, base) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func buildOr(args []node) (check, error) {
+	checks, err := compileAll(args)
+	if err != nil {
+		return nil, err
+	}
+	return func(id, base int) bool {
+		for _, c := range checks {
+			if c(id, base) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func buildNot(args []node) (check, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("takes one rule")
+	}
+	c, err := compile(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return func(id, base int) bool { return !c(id, base) }, nil
+}
+
+// recursive descent over name(arg, ...) expressions
+type ruleParser struct {
+	s   string
+	pos int
+}
+
+func (p *ruleParser) skipSpace() {
+	for p.pos < len(p.s) && p.s[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *ruleParser) expr() (node, error) {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && !strings.ContainsRune("(), ", rune(p.s[p.pos])) {
+		p.pos++
+	}
+	if p.pos == start {
+		return node{}, fmt.Errorf("rule %q: expected a rule at offset %d", p.s, start)
+	}
+	n := node{name: strings.ToLower(p.s[start:p.pos])}
+
+	p.skipSpace()
+	if p.pos == len(p.s) || p.s[p.pos] != '(' {
+		return n, nil
+	}
+	p.pos++
+
+	for {
+		arg, err := p.expr()
+		if err != nil {
+			return node{}, err
+		}
+		n.args = append(n.args, arg)
+
+		p.skipSpace()
+		if p.pos == len(p.s) {
+			return node{}, fmt.Errorf("rule %q: missing )", p.s)
+		}
+		switch p.s[p.pos] {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return n, nil
+		default:
+			return node{}, fmt.Errorf("rule %q: unexpected %q at offset %d", p.s, p.s[p.pos], p.pos)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 2: Invalidity Rules
+ *
+ * Tests verify each rule, their composition and parse errors.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// the named part rules agree with the hard-coded checks
+func TestRulePartsMatch(t *testing.T) {
+	part1, err := ParseRule("part1")
+	if err != nil {
+		t.Fatalf("ParseRule(part1) failed: %v", err)
+	}
+	part2, err := ParseRule("part2")
+	if err != nil {
+		t.Fatalf("ParseRule(part2) failed: %v", err)
+	}
+
+	for id := 0; id < 200000; id++ {
+		if part1(id) != IsInvalidIDPart1(id) {
+			t.Fatalf("part1(%d) = %v; expected %v", id, part1(id), IsInvalidIDPart1(id))
+		}
+		if part2(id) != IsInvalidIDPart2(id) {
+			t.Fatalf("part2(%d) = %v; expected %v", id, part2(id), IsInvalidIDPart2(id))
+		}
+	}
+}
+
+// individual rules and compositions
+func TestParseRule(t *testing.T) {
+	tests := []struct {
+		rule     string
+		id       int
+		expected bool
+	}{
+		{"palindrome", 12321, true},
+		{"palindrome", 1232, false},
+		{"repeats(3)", 121212, true},
+		{"repeats(3)", 1212, false},
+		{"repeats(2)", 121212, false},
+		{"repeats(1)", 7, true},
+		{"digitsum(=6)", 123, true},
+		{"digitsum(<6)", 123, false},
+		{"digitsum(>5)", 123, true},
+		{"digitsum(%4)", 1111, true},
+		{"base(2, part1)", 0b1010, true}, // 10 is 1010 in binary
+		{"base(2, palindrome)", 9, true}, // 1001
+		{"base(16, repeats(2))", 0xabab, true},
+		{"base(2, digitsum(=2))", 0b1001, true},
+		{"and(part2, palindrome)", 1111, true},
+		{"and(part2, palindrome)", 1212, false},
+		{"or(palindrome, part1)", 1212, true},
+		{"not(part2)", 1212, false},
+		{" AND( part2 , NOT(part1) ) ", 111, true},
+	}
+
+	for _, test := range tests {
+		rule, err := ParseRule(test.rule)
+		if err != nil {
+			t.Errorf("ParseRule(%q) unexpected error: %v", test.rule, err)
+			continue
+		}
+		if got := rule(test.id); got != test.expected {
+			t.Errorf("ParseRule(%q)(%d) = %v; expected %v", test.rule, test.id, got, test.expected)
+		}
+	}
+}
+
+// malformed rules are rejected with a reason
+func TestParseRuleErrors(t *testing.T) {
+	tests := []struct {
+		rule string
+		msg  string
+	}{
+		{"", "expected a rule"},
+		{"prime", "unknown rule"},
+		{"part1(2)", "takes no arguments"},
+		{"repeats", "takes one argument"},
+		{"repeats(x)", "expected a number"},
+		{"repeats(0)", "must be positive"},
+		{"digitsum(7)", "expected =, <, > or %"},
+		{"digitsum(%0)", "multiples of 0"},
+		{"base(1, part2)", "out of range"},
+		{"base(2)", "takes a base and a rule"},
+		{"and()", "expected a rule"},
+		{"not(part1", "missing )"},
+		{"part1 part2", "unexpected"},
+	}
+
+	for _, test := range tests {
+		_, err := ParseRule(test.rule)
+		if err == nil || !strings.Contains(err.Error(), test.msg) {
+			t.Errorf("ParseRule(%q) error = %v; expected %q", test.rule, err, test.msg)
+		}
+	}
+}
+
+// a rule sums like the built-in checks
+func TestRuleSum(t *testing.T) {
+	rule, err := ParseRule("and(part2, not(part1))")
+	if err != nil {
+		t.Fatalf("ParseRule failed: %v", err)
+	}
+
+	// only 111 is p2 but not p1 in 95-115
+	if sum := SumInvalidIDsInRanges([]IDRange{{95, 115}}, rule); sum != 111 {
+		t.Errorf("sum = %d; expected 111", sum)
+	}
+}
+
+// every rule is listed
+func TestWriteRules(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteRules(&buf); err != nil {
+		t.Fatalf("WriteRules failed: %v", err)
+	}
+	for name := range ruleBuilders {
+		if !strings.Contains(buf.String(), name) {
+			t.Errorf("WriteRules missing %q", name)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+/**
+ * Advent of Code 2025 - Day 2: Solver Registration
+ *
+ * Plugs the invalid ID summation into the shared solver registry.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package productid
+
+import (
+	"io"
+
+	"solver"
+)
+
+func init() {
+	solver.Register(daySolver{})
+}
+
+// daySolver parses ID range lists for the shared registry
+type daySolver struct{}
+
+// puzzle is a parsed list of ID ranges, big when the input needs it
+type puzzle Ranges
+
+func (daySolver) Day() int      { return 2 }
+func (daySolver) Title() string { return "Invalid Product IDs" }
+
+func (daySolver) Parse(r io.Reader) (solver.Puzzle, error) {
+	ranges, err := ParseRanges(r)
+	if err != nil {
+		return nil, err
+	}
+	return puzzle(ranges), nil
+}
+
+func (p puzzle) Part1() (solver.Answer, error) {
+	return solver.BigInt{Int: Ranges(p).SumPart1()}, nil
+}
+
+func (p puzzle) Part2() (solver.Answer, error) {
+	return solver.BigInt{Int: Ranges(p).SumPart2()}, nil
+}
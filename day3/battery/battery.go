@@ -0,0 +1,172 @@
+/**
+ * Advent of Code 2025 - Day 3: Battery Banks
+ *
+ * This package calculates maximum joltage from battery banks
+ * by selecting optimal battery combinations.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package battery
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// converts line of digits to battery joltage values
+func ParseBatteryBank(line string) ([]int, error) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return nil, nil
+	}
+
+	batteries := make([]int, len(line))
+	for i, char := range line {
+		if char < '0' || char > '9' {
+			return nil, fmt.Errorf("invalid character '%c' in battery bank", char)
+		}
+		batteries[i] = int(char - '0')
+	}
+
+	return batteries, nil
+}
+
+// max 2-digit joltage from any two batteries
+func FindMaxTwoDigitJoltage(batteries []int) int {
+	if len(batteries) < 2 {
+		return 0
+	}
+
+	maxJolt := 0
+	for i := 0; i < len(batteries); i++ {
+		for j := i + 1; j < len(batteries); j++ {
+			jolt := 10*batteries[i] + batteries[j]
+			if jolt > maxJolt {
+				maxJolt = jolt
+			}
+		}
+	}
+
+	return maxJolt
+}
+
+// max numeric subsequence of length k using monotonic stack
+func FindMaxSubsequence(batteries []int, k int) string {
+	n := len(batteries)
+	if k <= 0 {
+		return ""
+	}
+	if k >= n {
+		// Convert all batteries to string
+		var result strings.Builder
+		for _, battery := range batteries {
+			result.WriteString(strconv.Itoa(battery))
+		}
+		return result.String()
+	}
+
+	// Convert batteries to string digits for easier manipulation
+	digits := make([]byte, n)
+	for i, battery := range batteries {
+		digits[i] = byte('0' + battery)
+	}
+
+	stack := make([]byte, 0, k)
+
+	for i := 0; i < n; i++ {
+		c := digits[i]
+		remaining := n - i
+
+		// Remove smaller digits from stack if we have enough remaining digits
+		for len(stack) > 0 && stack[len(stack)-1] < c && len(stack)-1+remaining >= k {
+			stack = stack[:len(stack)-1]
+		}
+
+		// Add current digit if stack isn't full
+		if len(stack) < k {
+			stack = append(stack, c)
+		}
+	}
+
+	// Ensure we have exactly k digits
+	if len(stack) > k {
+		stack = stack[:k]
+	}
+
+	return string(stack)
+}
+
+// total maximum joltage for p1 (2 batteries per bank)
+func SumMaxJoltagesPart1(banks [][]int) int64 {
+	var total int64
+	for _, bank := range banks {
+		maxJolt := FindMaxTwoDigitJoltage(bank)
+		total += int64(maxJolt)
+	}
+	return total
+}
+
+// total maximum joltage for p2 (12 batteries per bank, big ints)
+func SumMaxJoltagesPart2(banks [][]int) *big.Int {
+	total := big.NewInt(0)
+
+	for _, bank := range banks {
+		maxSeq := FindMaxSubsequence(bank, 12)
+		if maxSeq != "" {
+			num := new(big.Int)
+			if _, ok := num.SetString(maxSeq, 10); !ok {
+				fmt.Printf("Warning: Failed to parse %q as big integer\n", maxSeq)
+				continue
+			}
+			total.Add(total, num)
+		}
+	}
+
+	return total
+}
+
+// reads and parses all battery banks from file, see Parse
+func ReadBatteryBanks(filename string) ([][]int, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+// parses one battery bank per line from any reader, skipping blank lines
+func Parse(r io.Reader) ([][]int, error) {
+	var banks [][]int
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		bank, err := ParseBatteryBank(line)
+		if err != nil {
+			return nil, err
+		}
+
+		if bank != nil {
+			banks = append(banks, bank)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return banks, nil
+}
@@ -7,11 +7,14 @@
  * Email: KleaSCM@gmail.com
  */
 
-package main
+package battery
 
 import (
+	"errors"
 	"math/big"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -137,25 +140,13 @@ func TestSumMaxJoltagesPart2(t *testing.T) {
 	}
 }
 
-// read banks
-func TestReadBatteryBanks(t *testing.T) {
-	// Create a temporary file with test data
+// parse banks
+func TestParse(t *testing.T) {
 	content := "12345\n67890\n"
 
-	tmpFile, err := os.CreateTemp("", "test_batteries_*.txt")
+	banks, err := Parse(strings.NewReader(content))
 	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
-	}
-	defer os.Remove(tmpFile.Name())
-
-	if _, err := tmpFile.WriteString(content); err != nil {
-		t.Fatalf("Failed to write to temp file: %v", err)
-	}
-	tmpFile.Close()
-
-	banks, err := ReadBatteryBanks(tmpFile.Name())
-	if err != nil {
-		t.Fatalf("ReadBatteryBanks failed: %v", err)
+		t.Fatalf("Parse failed: %v", err)
 	}
 
 	expected := [][]int{
@@ -221,3 +212,23 @@ func TestAllSameDigits(t *testing.T) {
 		t.Errorf("All same digits part1 = %d; expected %d", part1Result, expected)
 	}
 }
+
+// file wrapper
+func TestReadBatteryBanks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "banks.txt")
+	if err := os.WriteFile(path, []byte("987\n\n811\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	banks, err := ReadBatteryBanks(path)
+	if err != nil {
+		t.Fatalf("ReadBatteryBanks failed: %v", err)
+	}
+	if len(banks) != 2 || len(banks[1]) != 3 || banks[1][0] != 8 {
+		t.Errorf("ReadBatteryBanks = %v; expected [[9 8 7] [8 1 1]]", banks)
+	}
+
+	if _, err := ReadBatteryBanks("does_not_exist.txt"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadBatteryBanks(missing) error = %v; expected os.ErrNotExist", err)
+	}
+}
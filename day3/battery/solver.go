@@ -0,0 +1,45 @@
+/**
+ * Advent of Code 2025 - Day 3: Solver Registration
+ *
+ * Plugs the joltage calculations into the shared solver registry.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package battery
+
+import (
+	"io"
+
+	"solver"
+)
+
+func init() {
+	solver.Register(daySolver{})
+}
+
+// daySolver parses battery banks for the shared registry
+type daySolver struct{}
+
+// puzzle is a parsed list of battery banks
+type puzzle [][]int
+
+func (daySolver) Day() int      { return 3 }
+func (daySolver) Title() string { return "Battery Banks" }
+
+func (daySolver) Parse(r io.Reader) (solver.Puzzle, error) {
+	banks, err := Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return puzzle(banks), nil
+}
+
+func (p puzzle) Part1() (solver.Answer, error) {
+	return solver.Int(SumMaxJoltagesPart1(p)), nil
+}
+
+func (p puzzle) Part2() (solver.Answer, error) {
+	return solver.BigInt{Int: SumMaxJoltagesPart2(p)}, nil
+}
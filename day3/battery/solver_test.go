@@ -0,0 +1,33 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 3: Solver Registration
+ *
+ * Tests verify the registered solver reproduces every example fixture,
+ * and benchmark it on those fixtures and a large synthetic input.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package battery
+
+import (
+	"testing"
+
+	"solver"
+	"solver/gen"
+	"solver/solvertest"
+)
+
+// registered solver against the stated example answers
+func TestRegisteredSolver(t *testing.T) {
+	if _, ok := solver.Lookup(3); !ok {
+		t.Fatalf("day 3 solver not registered")
+	}
+	solvertest.RunDir(t, daySolver{}, "../examples")
+}
+
+// parse and solve both parts, examples and large input
+func BenchmarkSolver(b *testing.B) {
+	solvertest.BenchDir(b, daySolver{}, "../examples")
+	solvertest.Bench(b, daySolver{}, "large", gen.BatteryBanks(gen.New(3), 200, 100))
+}
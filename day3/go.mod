@@ -1,3 +1,7 @@
 module day3
 
 go 1.24.1
+
+require solver v0.0.0
+
+replace solver => ../solver
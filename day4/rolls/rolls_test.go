@@ -7,10 +7,13 @@
  * Email: KleaSCM@gmail.com
  */
 
-package main
+package rolls
 
 import (
+	"errors"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -165,25 +168,13 @@ func TestCountTotalRemovableRollsFullRemoval(t *testing.T) {
 	}
 }
 
-// read input
-func TestReadInput(t *testing.T) {
-	// Create a temporary file with test data
+// parse grid
+func TestParse(t *testing.T) {
 	content := "..@@.\n@@@.@\n"
 
-	tmpFile, err := os.CreateTemp("", "test_input_*.txt")
+	grid, err := Parse(strings.NewReader(content))
 	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
-	}
-	defer os.Remove(tmpFile.Name())
-
-	if _, err := tmpFile.WriteString(content); err != nil {
-		t.Fatalf("Failed to write to temp file: %v", err)
-	}
-	tmpFile.Close()
-
-	grid, err := ReadInput(tmpFile.Name())
-	if err != nil {
-		t.Fatalf("ReadInput failed: %v", err)
+		t.Fatalf("Parse failed: %v", err)
 	}
 
 	expected := Grid{
@@ -207,3 +198,23 @@ func TestReadInput(t *testing.T) {
 		}
 	}
 }
+
+// file wrapper
+func TestReadInput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "grid.txt")
+	if err := os.WriteFile(path, []byte("..@\n@@.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	grid, err := ReadInput(path)
+	if err != nil {
+		t.Fatalf("ReadInput failed: %v", err)
+	}
+	if len(grid) != 2 || string(grid[0]) != "..@" || string(grid[1]) != "@@." {
+		t.Errorf("ReadInput = %q; expected [..@ @@.]", grid)
+	}
+
+	if _, err := ReadInput("does_not_exist.txt"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadInput(missing) error = %v; expected os.ErrNotExist", err)
+	}
+}
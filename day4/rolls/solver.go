@@ -0,0 +1,45 @@
+/**
+ * Advent of Code 2025 - Day 4: Solver Registration
+ *
+ * Plugs the roll accessibility counts into the shared solver registry.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package rolls
+
+import (
+	"io"
+
+	"solver"
+)
+
+func init() {
+	solver.Register(daySolver{})
+}
+
+// daySolver parses roll grids for the shared registry
+type daySolver struct{}
+
+// puzzle is a parsed roll grid
+type puzzle Grid
+
+func (daySolver) Day() int      { return 4 }
+func (daySolver) Title() string { return "Printing Department" }
+
+func (daySolver) Parse(r io.Reader) (solver.Puzzle, error) {
+	grid, err := Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return puzzle(grid), nil
+}
+
+func (p puzzle) Part1() (solver.Answer, error) {
+	return solver.Int(CountAccessibleRolls(Grid(p))), nil
+}
+
+func (p puzzle) Part2() (solver.Answer, error) {
+	return solver.Int(CountTotalRemovableRolls(Grid(p))), nil
+}
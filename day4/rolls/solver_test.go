@@ -0,0 +1,33 @@
+/**
+ * Test suite for Advent of Code 2025 - Day 4: Solver Registration
+ *
+ * Tests verify the registered solver reproduces every example fixture,
+ * and benchmark it on those fixtures and a large synthetic input.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package rolls
+
+import (
+	"testing"
+
+	"solver"
+	"solver/gen"
+	"solver/solvertest"
+)
+
+// registered solver against the stated example answers
+func TestRegisteredSolver(t *testing.T) {
+	if _, ok := solver.Lookup(4); !ok {
+		t.Fatalf("day 4 solver not registered")
+	}
+	solvertest.RunDir(t, daySolver{}, "../examples")
+}
+
+// parse and solve both parts, examples and large input
+func BenchmarkSolver(b *testing.B) {
+	solvertest.BenchDir(b, daySolver{}, "../examples")
+	solvertest.Bench(b, daySolver{}, "large", gen.RollGrid(gen.New(4), 140, 140, 0.6))
+}
@@ -7,10 +7,13 @@
  * Email: KleaSCM@gmail.com
  */
 
-package main
+package ranges
 
 import (
+	"errors"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -84,7 +87,7 @@ func TestIsFresh(t *testing.T) {
 
 // tests the complete ingredient counting
 func TestCountFreshIngredients(t *testing.T) {
-	// Create a temporary file with test data matching the problem example
+	// Test data matching the problem example
 	content := `3-5
 10-14
 16-20
@@ -97,25 +100,16 @@ func TestCountFreshIngredients(t *testing.T) {
 17
 32`
 
-	tmpFile, err := os.CreateTemp("", "test_ingredients_*.txt")
+	inv, err := Parse(strings.NewReader(content))
 	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
+		t.Fatalf("Parse failed: %v", err)
 	}
-	defer os.Remove(tmpFile.Name())
 
-	if _, err := tmpFile.WriteString(content); err != nil {
-		t.Fatalf("Failed to write to temp file: %v", err)
-	}
-	tmpFile.Close()
-
-	result, err := CountFreshIngredients(tmpFile.Name())
-	if err != nil {
-		t.Fatalf("CountFreshIngredients failed: %v", err)
-	}
+	result := CountFresh(inv.AvailableIDs, inv.Ranges)
 
 	expected := 3 // IDs 5, 11, 17 are fresh according to the example
 	if result != expected {
-		t.Errorf("CountFreshIngredients() = %d; expected %d", result, expected)
+		t.Errorf("CountFresh() = %d; expected %d", result, expected)
 	}
 }
 
@@ -126,25 +120,16 @@ func TestCountFreshIngredientsEmptyRanges(t *testing.T) {
 5
 8`
 
-	tmpFile, err := os.CreateTemp("", "test_empty_ranges_*.txt")
+	inv, err := Parse(strings.NewReader(content))
 	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
+		t.Fatalf("Parse failed: %v", err)
 	}
-	defer os.Remove(tmpFile.Name())
 
-	if _, err := tmpFile.WriteString(content); err != nil {
-		t.Fatalf("Failed to write to temp file: %v", err)
-	}
-	tmpFile.Close()
-
-	result, err := CountFreshIngredients(tmpFile.Name())
-	if err != nil {
-		t.Fatalf("CountFreshIngredients failed: %v", err)
-	}
+	result := CountFresh(inv.AvailableIDs, inv.Ranges)
 
 	expected := 0 // no ranges defined, so no IDs are fresh
 	if result != expected {
-		t.Errorf("CountFreshIngredients empty ranges = %d; expected %d", result, expected)
+		t.Errorf("CountFresh empty ranges = %d; expected %d", result, expected)
 	}
 }
 
@@ -154,25 +139,16 @@ func TestCountFreshIngredientsNoIDs(t *testing.T) {
 10-14
 `
 
-	tmpFile, err := os.CreateTemp("", "test_no_ids_*.txt")
+	inv, err := Parse(strings.NewReader(content))
 	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
+		t.Fatalf("Parse failed: %v", err)
 	}
-	defer os.Remove(tmpFile.Name())
 
-	if _, err := tmpFile.WriteString(content); err != nil {
-		t.Fatalf("Failed to write to temp file: %v", err)
-	}
-	tmpFile.Close()
-
-	result, err := CountFreshIngredients(tmpFile.Name())
-	if err != nil {
-		t.Fatalf("CountFreshIngredients failed: %v", err)
-	}
+	result := CountFresh(inv.AvailableIDs, inv.Ranges)
 
 	expected := 0 // no IDs to check
 	if result != expected {
-		t.Errorf("CountFreshIngredients no IDs = %d; expected %d", result, expected)
+		t.Errorf("CountFresh no IDs = %d; expected %d", result, expected)
 	}
 }
 
@@ -265,31 +241,65 @@ func TestCountUniqueIDsInRanges(t *testing.T) {
 
 // tests the complete Part 2 processing
 func TestCountTotalFreshIngredients(t *testing.T) {
-	// create a temporary file with test ranges
 	content := `3-5
 10-14
 16-20
 12-18
 `
 
-	tmpFile, err := os.CreateTemp("", "test_ranges_*.txt")
+	inv, err := Parse(strings.NewReader(content))
 	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
+		t.Fatalf("Parse failed: %v", err)
 	}
-	defer os.Remove(tmpFile.Name())
 
-	if _, err := tmpFile.WriteString(content); err != nil {
-		t.Fatalf("Failed to write to temp file: %v", err)
+	result := CountUniqueIDsInRanges(inv.Ranges)
+
+	expected := int64(14) // From the example in the problem
+	if result != expected {
+		t.Errorf("CountUniqueIDsInRanges() = %d; expected %d", result, expected)
 	}
-	tmpFile.Close()
+}
 
-	result, err := CountTotalFreshIngredients(tmpFile.Name())
+// p2 from a file stops at the blank line, so bad IDs after it are
+// never read
+func TestCountTotalFreshIngredientsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ingredients.txt")
+	if err := os.WriteFile(path, []byte("3-5\n10-14\n16-20\n12-18\n\n1\nnot an id\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := CountTotalFreshIngredients(path)
 	if err != nil {
 		t.Fatalf("CountTotalFreshIngredients failed: %v", err)
 	}
+	if result != 14 {
+		t.Errorf("CountTotalFreshIngredients() = %d; expected 14", result)
+	}
 
-	expected := int64(14) // From the example in the problem
-	if result != expected {
-		t.Errorf("CountTotalFreshIngredients() = %d; expected %d", result, expected)
+	if _, err := CountFreshIngredients(path); err == nil {
+		t.Errorf("CountFreshIngredients with a bad ID expected error but got none")
+	}
+}
+
+// file wrapper
+func TestReadInventory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ingredients.txt")
+	if err := os.WriteFile(path, []byte("3-5\n10-14\n16-20\n12-18\n\n1\n5\n8\n11\n17\n32\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	inv, err := ReadInventory(path)
+	if err != nil {
+		t.Fatalf("ReadInventory failed: %v", err)
+	}
+	if len(inv.Ranges) != 4 || len(inv.AvailableIDs) != 6 {
+		t.Errorf("ReadInventory = %d ranges, %d IDs; expected 4, 6", len(inv.Ranges), len(inv.AvailableIDs))
+	}
+	if result, err := CountFreshIngredients(path); err != nil || result != 3 {
+		t.Errorf("CountFreshIngredients() = %d, %v; expected 3", result, err)
+	}
+
+	if _, err := ReadInventory("does_not_exist.txt"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadInventory(missing) error = %v; expected os.ErrNotExist", err)
 	}
 }
@@ -0,0 +1,45 @@
+/**
+ * Advent of Code 2025 - Day 5: Solver Registration
+ *
+ * Plugs the fresh ingredient counts into the shared solver registry.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package ranges
+
+import (
+	"io"
+
+	"solver"
+)
+
+func init() {
+	solver.Register(daySolver{})
+}
+
+// daySolver parses the ingredient database for the shared registry
+type daySolver struct{}
+
+// puzzle is a parsed ingredient database
+type puzzle Inventory
+
+func (daySolver) Day() int      { return 5 }
+func (daySolver) Title() string { return "Cafeteria Inventory" }
+
+func (daySolver) Parse(r io.Reader) (solver.Puzzle, error) {
+	inv, err := Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return puzzle(inv), nil
+}
+
+func (p puzzle) Part1() (solver.Answer, error) {
+	return solver.Int(CountFresh(p.AvailableIDs, p.Ranges)), nil
+}
+
+func (p puzzle) Part2() (solver.Answer, error) {
+	return solver.Int(CountUniqueIDsInRanges(p.Ranges)), nil
+}
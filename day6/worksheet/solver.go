@@ -0,0 +1,49 @@
+/**
+ * Advent of Code 2025 - Day 6: Solver Registration
+ *
+ * Plugs the worksheet totals into the shared solver registry.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package worksheet
+
+import (
+	"io"
+
+	"solver"
+)
+
+func init() {
+	solver.Register(daySolver{})
+}
+
+// daySolver reads worksheets for the shared registry
+type daySolver struct{}
+
+// puzzle is the raw worksheet, since both parts need the original spacing
+type puzzle []string
+
+func (daySolver) Day() int      { return 6 }
+func (daySolver) Title() string { return "Trash Compactor Math" }
+
+func (daySolver) Parse(r io.Reader) (solver.Puzzle, error) {
+	lines, err := Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return puzzle(lines), nil
+}
+
+func (p puzzle) Part1() (solver.Answer, error) {
+	total, err := SolvePart1(p)
+	if err != nil {
+		return nil, err
+	}
+	return solver.Int(total), nil
+}
+
+func (p puzzle) Part2() (solver.Answer, error) {
+	return solver.Int(SolvePart2(p)), nil
+}
@@ -0,0 +1,278 @@
+/**
+ * Advent of Code 2025 - Day 6: Trash Compactor Math (P1,2)
+ *
+ * This package parses visual math worksheets in both left-to-right
+ * (P1) and right-to-left cephalopod format (P2).
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+package worksheet
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// MathProblem is one worksheet problem: its numbers and the operator
+// ('+' or '*') printed underneath them
+type MathProblem struct {
+	Numbers []int
+	Op      rune
+}
+
+// reads every line of the worksheet file, see Parse
+func ReadLines(filename string) ([]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Parse(f)
+}
+
+// collects raw worksheet lines from any reader without trimming,
+// since column alignment matters
+func Parse(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// adds or multiplies the numbers of a single problem
+func SolveProblem(problem MathProblem) int {
+	if len(problem.Numbers) == 0 {
+		return 0
+	}
+
+	if problem.Op == '+' {
+		sum := 0
+		for _, n := range problem.Numbers {
+			sum += n
+		}
+		return sum
+	}
+
+	prod := 1
+	for _, n := range problem.Numbers {
+		prod *= n
+	}
+	return prod
+}
+
+// sums the answers of every problem on the worksheet
+func solveAll(problems []MathProblem) int {
+	total := 0
+	for _, p := range problems {
+		total += SolveProblem(p)
+	}
+	return total
+}
+
+// splits the worksheet into problems reading numbers left-to-right,
+// one whitespace-separated number per row (p1)
+func ProblemsPart1(lines []string) ([]MathProblem, error) {
+	if len(lines) == 0 {
+		return nil, nil
+	}
+
+	numberOfRows := len(lines) - 1
+	ops := extractOperators(lines[numberOfRows])
+
+	rows := make([][]int, numberOfRows)
+	for j := 0; j < numberOfRows; j++ {
+		nums, err := extractNumbers(lines[j])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", j+1, err)
+		}
+		if len(nums) != len(ops) {
+			return nil, fmt.Errorf("line %d: %d numbers for %d operators", j+1, len(nums), len(ops))
+		}
+		rows[j] = nums
+	}
+
+	problems := make([]MathProblem, len(ops))
+	for i, op := range ops {
+		nums := make([]int, numberOfRows)
+		for j := range rows {
+			nums[j] = rows[j][i]
+		}
+		problems[i] = MathProblem{Numbers: nums, Op: op}
+	}
+
+	return problems, nil
+}
+
+func extractNumbers(line string) ([]int, error) {
+	var nums []int
+	for _, part := range strings.Fields(line) {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number: %s", part)
+		}
+		nums = append(nums, n)
+	}
+	return nums, nil
+}
+
+func extractOperators(line string) []rune {
+	var ops []rune
+	for _, c := range line {
+		if c == '+' || c == '*' {
+			ops = append(ops, c)
+		}
+	}
+	return ops
+}
+
+// grand total reading each problem left-to-right (p1)
+func SolvePart1(lines []string) (int, error) {
+	problems, err := ProblemsPart1(lines)
+	if err != nil {
+		return 0, err
+	}
+	return solveAll(problems), nil
+}
+
+// splits the worksheet into problems reading cephalopod math: problems
+// right-to-left, each column one number read top to bottom (p2)
+func ProblemsPart2(lines []string) []MathProblem {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	// pad lines to same width
+	maxWidth := 0
+	for _, line := range lines {
+		if len(line) > maxWidth {
+			maxWidth = len(line)
+		}
+	}
+
+	grid := make([][]rune, len(lines))
+	for i, line := range lines {
+		padded := line + strings.Repeat(" ", maxWidth-len(line))
+		grid[i] = []rune(padded)
+	}
+
+	rows := len(grid)
+	cols := maxWidth
+	opRow := rows - 1
+
+	isBlankColumn := func(c int) bool {
+		for r := 0; r < rows; r++ {
+			if grid[r][c] != ' ' {
+				return false
+			}
+		}
+		return true
+	}
+
+	// group non-blank columns into problems
+	var groups [][]int
+	var current []int
+
+	for c := 0; c < cols; c++ {
+		if isBlankColumn(c) {
+			if len(current) > 0 {
+				groups = append(groups, current)
+			}
+			current = nil
+		} else {
+			current = append(current, c)
+		}
+	}
+	if len(current) > 0 {
+		groups = append(groups, current)
+	}
+
+	var problems []MathProblem
+	for gi := len(groups) - 1; gi >= 0; gi-- {
+		columns := groups[gi]
+
+		op := rune(0)
+		for _, col := range columns {
+			if grid[opRow][col] == '+' || grid[opRow][col] == '*' {
+				op = grid[opRow][col]
+				break
+			}
+		}
+
+		var numbers []int
+		for i := len(columns) - 1; i >= 0; i-- {
+			col := columns[i]
+			numStr := ""
+			for r := 0; r < opRow; r++ {
+				if grid[r][col] != ' ' {
+					numStr += string(grid[r][col])
+				}
+			}
+			if numStr != "" {
+				n, _ := strconv.Atoi(numStr)
+				numbers = append(numbers, n)
+			}
+		}
+
+		// an operator with nothing above it is not a problem
+		if len(numbers) == 0 {
+			continue
+		}
+		problems = append(problems, MathProblem{Numbers: numbers, Op: op})
+	}
+
+	return problems
+}
+
+// grand total reading cephalopod math right-to-left in columns (p2)
+func SolvePart2(lines []string) int {
+	return solveAll(ProblemsPart2(lines))
+}
+
+// reads a worksheet file and returns its problems in p1 order
+func ParseWorksheetPart1(filename string) ([]MathProblem, error) {
+	lines, err := ReadLines(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ProblemsPart1(lines)
+}
+
+// reads a worksheet file and returns its grand total for p1
+func SolveWorksheetPart1(filename string) (int, error) {
+	lines, err := ReadLines(filename)
+	if err != nil {
+		return 0, err
+	}
+	return SolvePart1(lines)
+}
+
+// reads a worksheet file and returns its problems in p2 (cephalopod) order
+func ParseWorksheetPart2(filename string) ([]MathProblem, error) {
+	lines, err := ReadLines(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ProblemsPart2(lines), nil
+}
+
+// reads a worksheet file and returns its cephalopod grand total (p2)
+func SolveWorksheet(filename string) (int, error) {
+	lines, err := ReadLines(filename)
+	if err != nil {
+		return 0, err
+	}
+	return SolvePart2(lines), nil
+}
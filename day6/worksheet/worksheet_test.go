@@ -8,13 +8,27 @@
  * Email: KleaSCM@gmail.com
  */
 
-package main
+package worksheet
 
 import (
+	"errors"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
+// parses worksheet content held in memory
+func parseLines(t *testing.T, content string) []string {
+	t.Helper()
+
+	lines, err := Parse(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	return lines
+}
+
 // tests individual math problem solving
 func TestSolveProblem(t *testing.T) {
 	tests := []struct {
@@ -53,20 +67,9 @@ func TestParseWorksheet(t *testing.T) {
   6 98  215 314
 *   +   *   +  `
 
-	tmpFile, err := os.CreateTemp("", "test_worksheet_*.txt")
+	problems, err := ProblemsPart1(parseLines(t, content))
 	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
-	}
-	defer os.Remove(tmpFile.Name())
-
-	if _, err := tmpFile.WriteString(content); err != nil {
-		t.Fatalf("Failed to write to temp file: %v", err)
-	}
-	tmpFile.Close()
-
-	problems, err := ParseWorksheetPart1(tmpFile.Name())
-	if err != nil {
-		t.Fatalf("ParseWorksheetPart1 failed: %v", err)
+		t.Fatalf("ProblemsPart1 failed: %v", err)
 	}
 
 	expected := []MathProblem{
@@ -106,26 +109,15 @@ func TestSolveWorksheetPart1(t *testing.T) {
   6 98  215 314
 *   +   *   +  `
 
-	tmpFile, err := os.CreateTemp("", "test_worksheet_p1_*.txt")
-	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
-	}
-	defer os.Remove(tmpFile.Name())
-
-	if _, err := tmpFile.WriteString(content); err != nil {
-		t.Fatalf("Failed to write to temp file: %v", err)
-	}
-	tmpFile.Close()
-
-	result, err := SolveWorksheetPart1(tmpFile.Name())
+	result, err := SolvePart1(parseLines(t, content))
 	if err != nil {
-		t.Fatalf("SolveWorksheetPart1 failed: %v", err)
+		t.Fatalf("SolvePart1 failed: %v", err)
 	}
 
 	// Expected: 33210 + 490 + 4243455 + 401 = 4277556
 	expected := 33210 + 490 + 4243455 + 401
 	if result != expected {
-		t.Errorf("SolveWorksheetPart1() = %d; expected %d", result, expected)
+		t.Errorf("SolvePart1() = %d; expected %d", result, expected)
 	}
 }
 
@@ -150,21 +142,7 @@ func TestEmptyWorksheet(t *testing.T) {
 	content := `
 *`
 
-	tmpFile, err := os.CreateTemp("", "test_empty_*.txt")
-	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
-	}
-	defer os.Remove(tmpFile.Name())
-
-	if _, err := tmpFile.WriteString(content); err != nil {
-		t.Fatalf("Failed to write to temp file: %v", err)
-	}
-	tmpFile.Close()
-
-	result, err := SolveWorksheet(tmpFile.Name())
-	if err != nil {
-		t.Fatalf("SolveWorksheet failed: %v", err)
-	}
+	result := SolvePart2(parseLines(t, content))
 
 	//np maybe? idk
 	if result != 0 {
@@ -177,21 +155,7 @@ func TestSingleNumberProblems(t *testing.T) {
 	content := `5
 +`
 
-	tmpFile, err := os.CreateTemp("", "test_single_*.txt")
-	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
-	}
-	defer os.Remove(tmpFile.Name())
-
-	if _, err := tmpFile.WriteString(content); err != nil {
-		t.Fatalf("Failed to write to temp file: %v", err)
-	}
-	tmpFile.Close()
-
-	result, err := SolveWorksheet(tmpFile.Name())
-	if err != nil {
-		t.Fatalf("SolveWorksheet failed: %v", err)
-	}
+	result := SolvePart2(parseLines(t, content))
 
 	// Single num with any operation should return that num?
 	expected := 5
@@ -222,21 +186,7 @@ func TestParseWorksheetPart2(t *testing.T) {
   6 98  215 314
 *   +   *   +  `
 
-	tmpFile, err := os.CreateTemp("", "test_worksheet_part2_*.txt")
-	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
-	}
-	defer os.Remove(tmpFile.Name())
-
-	if _, err := tmpFile.WriteString(content); err != nil {
-		t.Fatalf("Failed to write to temp file: %v", err)
-	}
-	tmpFile.Close()
-
-	problems, err := ParseWorksheetPart2(tmpFile.Name())
-	if err != nil {
-		t.Fatalf("ParseWorksheetPart2 failed: %v", err)
-	}
+	problems := ProblemsPart2(parseLines(t, content))
 
 	// Expected right-to-left processing order:
 	// 0. rightmost column: 4 + 431 + 623
@@ -281,25 +231,59 @@ func TestSolveWorksheetPart2(t *testing.T) {
   6 98  215 314
 *   +   *   +  `
 
-	tmpFile, err := os.CreateTemp("", "test_worksheet_solve_part2_*.txt")
-	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
+	result := SolvePart2(parseLines(t, content))
+
+	// Expected: 356*24*1 + 8+248+369 + 175*581*32 + 4+431+623 = 8544 + 625 + 3253600 + 1058 = 3263827
+	expected := 356*24*1 + 8 + 248 + 369 + 175*581*32 + 4 + 431 + 623
+	if result != expected {
+		t.Errorf("SolvePart2() = %d; expected %d", result, expected)
+	}
+}
+
+// mismatched rows p1
+func TestProblemsPart1Mismatch(t *testing.T) {
+	tests := [][]string{
+		{"1 2 3", "4 5", "+ * +"}, // short row
+		{"1 2", "4 x", "+ *"},     // not a number
 	}
-	defer os.Remove(tmpFile.Name())
 
-	if _, err := tmpFile.WriteString(content); err != nil {
-		t.Fatalf("Failed to write to temp file: %v", err)
+	for _, lines := range tests {
+		if _, err := ProblemsPart1(lines); err == nil {
+			t.Errorf("ProblemsPart1(%q) expected error but got none", lines)
+		}
 	}
-	tmpFile.Close()
+}
 
-	result, err := SolveWorksheet(tmpFile.Name())
+// file wrappers, both parts from one worksheet
+func TestReadLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "worksheet.txt")
+	content := "123 328  51 64 \n 45 64  387 23 \n  6 98  215 314\n*   +   *   +  \n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	lines, err := ReadLines(path)
 	if err != nil {
-		t.Fatalf("SolveWorksheet failed: %v", err)
+		t.Fatalf("ReadLines failed: %v", err)
+	}
+	if len(lines) != 4 || lines[0] != "123 328  51 64 " {
+		t.Errorf("ReadLines = %q; expected the 4 untrimmed rows", lines)
 	}
 
-	// Expected: 356*24*1 + 8+248+369 + 175*581*32 + 4+431+623 = 8544 + 625 + 3253600 + 1058 = 3263827
-	expected := 356*24*1 + 8 + 248 + 369 + 175*581*32 + 4 + 431 + 623
-	if result != expected {
-		t.Errorf("SolveWorksheet() = %d; expected %d", result, expected)
+	if problems, err := ParseWorksheetPart1(path); err != nil || len(problems) != 4 {
+		t.Errorf("ParseWorksheetPart1 = %d problems, %v; expected 4", len(problems), err)
+	}
+	if problems, err := ParseWorksheetPart2(path); err != nil || len(problems) != 4 {
+		t.Errorf("ParseWorksheetPart2 = %d problems, %v; expected 4", len(problems), err)
+	}
+	if total, err := SolveWorksheetPart1(path); err != nil || total != 4277556 {
+		t.Errorf("SolveWorksheetPart1 = %d, %v; expected 4277556", total, err)
+	}
+	if total, err := SolveWorksheet(path); err != nil || total != 3263827 {
+		t.Errorf("SolveWorksheet = %d, %v; expected 3263827", total, err)
+	}
+
+	if _, err := ReadLines("does_not_exist.txt"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadLines(missing) error = %v; expected os.ErrNotExist", err)
 	}
 }
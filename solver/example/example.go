@@ -0,0 +1,144 @@
+/**
+ * Advent of Code 2025 - Example Fixtures
+ *
+ * Worked examples from the problem statements, stored as fixture
+ * files next to each day's problem text. A fixture is a header of
+ * stated answers, a --- separator line, then the input verbatim:
+ *
+ *	part 1: 3
+ *	part 2: 6
+ *	---
+ *	L68
+ *	...
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package example
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// separator ends the answer header
+const separator = "---"
+
+// Example is one worked example and the answers the text states for it
+type Example struct {
+	Name    string
+	Input   string
+	Answers map[int]string
+}
+
+// Parts returns the parts that have a stated answer, in order
+func (e Example) Parts() []int {
+	parts := make([]int, 0, len(e.Answers))
+	for part := range e.Answers {
+		parts = append(parts, part)
+	}
+	sort.Ints(parts)
+	return parts
+}
+
+// Format renders the example in fixture form
+func (e Example) Format() string {
+	var b strings.Builder
+	for _, part := range e.Parts() {
+		fmt.Fprintf(&b, "part %d: %s\n", part, e.Answers[part])
+	}
+	b.WriteString(separator + "\n")
+	b.WriteString(e.Input)
+	return b.String()
+}
+
+// parses a fixture's text, name is used for errors only
+func Parse(name, text string) (Example, error) {
+	header, input, ok := strings.Cut(text, separator+"\n")
+	if !ok {
+		return Example{}, fmt.Errorf("%s: missing %s separator", name, separator)
+	}
+
+	e := Example{Name: name, Input: input, Answers: make(map[int]string)}
+
+	scanner := bufio.NewScanner(strings.NewReader(header))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		key, answer, ok := strings.Cut(line, ":")
+		part, err := strconv.Atoi(strings.TrimPrefix(key, "part "))
+		if !ok || err != nil || !strings.HasPrefix(key, "part ") {
+			return Example{}, fmt.Errorf("%s:%d: invalid answer line %q", name, n, line)
+		}
+		e.Answers[part] = strings.TrimSpace(answer)
+	}
+
+	return e, nil
+}
+
+// reads every example*.txt fixture in dir, ordered by name;
+// a missing dir simply has no examples
+func Load(dir string) ([]Example, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "example*.txt"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var examples []Example
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := Parse(filepath.Base(path), string(data))
+		if err != nil {
+			return nil, err
+		}
+		examples = append(examples, e)
+	}
+
+	return examples, nil
+}
+
+// replaces the fixtures in dir with examples, named example1.txt,
+// example2.txt, ... in order
+func Save(dir string, examples []Example) error {
+	stale, err := filepath.Glob(filepath.Join(dir, "example*.txt"))
+	if err != nil {
+		return err
+	}
+	for _, path := range stale {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	for i, e := range examples {
+		path := filepath.Join(dir, fmt.Sprintf("example%d.txt", i+1))
+		if err := os.WriteFile(path, []byte(e.Format()), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fixture directory for day under a repository root
+func Dir(root string, day int) string {
+	return filepath.Join(root, fmt.Sprintf("day%d", day), "examples")
+}
@@ -0,0 +1,103 @@
+/**
+ * Test suite for Advent of Code 2025 - Example Fixtures
+ *
+ * Tests verify the fixture format round-trips and rejects bad headers.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package example
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// format then parse
+func TestFormatParseRoundTrip(t *testing.T) {
+	e := Example{
+		Name:    "example1.txt",
+		Input:   "123 328  51 64 \n*   +   *   +  \n",
+		Answers: map[int]string{2: "6", 1: "3"},
+	}
+
+	text := e.Format()
+	expected := "part 1: 3\npart 2: 6\n---\n123 328  51 64 \n*   +   *   +  \n"
+	if text != expected {
+		t.Fatalf("Format() = %q; expected %q", text, expected)
+	}
+
+	got, err := Parse(e.Name, text)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if got.Input != e.Input {
+		t.Errorf("Input = %q; expected %q", got.Input, e.Input)
+	}
+	if got.Answers[1] != "3" || got.Answers[2] != "6" {
+		t.Errorf("Answers = %v; expected part 1 = 3, part 2 = 6", got.Answers)
+	}
+}
+
+// bad fixtures
+func TestParseInvalid(t *testing.T) {
+	tests := []string{
+		"part 1: 3\nL68\n",
+		"answer: 3\n---\nL68\n",
+		"part one: 3\n---\nL68\n",
+	}
+
+	for _, text := range tests {
+		if _, err := Parse("bad.txt", text); err == nil {
+			t.Errorf("Parse(%q) expected error but got none", text)
+		}
+	}
+}
+
+// save replaces stale fixtures, load reads them back in order
+func TestSaveLoad(t *testing.T) {
+	dir, err := os.MkdirTemp("", "test_examples_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.WriteFile(filepath.Join(dir, "example9.txt"), []byte("---\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write stale fixture: %v", err)
+	}
+
+	examples := []Example{
+		{Input: "a\n", Answers: map[int]string{1: "1"}},
+		{Input: "b\n", Answers: map[int]string{2: "2"}},
+	}
+	if err := Save(dir, examples); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(loaded) != 2 {
+		t.Fatalf("Load returned %d examples; expected 2", len(loaded))
+	}
+	if loaded[0].Name != "example1.txt" || loaded[0].Input != "a\n" {
+		t.Errorf("first example = %+v; expected example1.txt with input a", loaded[0])
+	}
+	if loaded[1].Answers[2] != "2" {
+		t.Errorf("second example answers = %v; expected part 2 = 2", loaded[1].Answers)
+	}
+}
+
+// missing directory
+func TestLoadMissingDir(t *testing.T) {
+	examples, err := Load(filepath.Join(os.TempDir(), "no_such_examples_dir"))
+	if err != nil {
+		t.Fatalf("Load of missing dir unexpected error: %v", err)
+	}
+	if len(examples) != 0 {
+		t.Errorf("Load of missing dir returned %d examples; expected 0", len(examples))
+	}
+}
@@ -0,0 +1,187 @@
+/**
+ * Advent of Code 2025 - Synthetic Inputs
+ *
+ * Seeded random inputs in each day's exact puzzle format, sized
+ * well past the worked examples, for benchmarks and stress tests.
+ * The same seed and size always give the same input.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+// DefaultSizes is the size Day uses for each day when size is 0,
+// roughly the scale of a real puzzle input
+var DefaultSizes = map[int]int{
+	1: 4000,
+	2: 20,
+	3: 200,
+	4: 140,
+	5: 200,
+	6: 1000,
+}
+
+// returns a generator seeded with seed
+func New(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// generates an input for day; size is the day's main dimension
+// (rotations, ranges, banks, grid side, fresh ranges, problems)
+// and 0 picks DefaultSizes
+func Day(day int, seed uint64, size int) (string, error) {
+	if size == 0 {
+		size = DefaultSizes[day]
+	}
+	if size < 0 {
+		return "", fmt.Errorf("invalid size %d", size)
+	}
+
+	rng := New(seed)
+	switch day {
+	case 1:
+		return Rotations(rng, size, 999), nil
+	case 2:
+		return IDRanges(rng, size, 50_000), nil
+	case 3:
+		return BatteryBanks(rng, size, 100), nil
+	case 4:
+		return RollGrid(rng, size, size, 0.6), nil
+	case 5:
+		return Inventory(rng, size, 5*size), nil
+	case 6:
+		return Worksheet(rng, size, 4), nil
+	}
+	return "", fmt.Errorf("no generator for day %d", day)
+}
+
+// day 1: n rotations such as L68 or R14, each of 1 to maxDistance clicks
+func Rotations(rng *rand.Rand, n, maxDistance int) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		dir := byte('L')
+		if rng.IntN(2) == 1 {
+			dir = 'R'
+		}
+		b.WriteByte(dir)
+		b.WriteString(strconv.Itoa(1 + rng.IntN(maxDistance)))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// day 2: n comma-separated ID ranges on one line, each starting at an
+// ID of up to ten digits (at most 9,000,000,000) and spanning up to
+// maxWidth IDs
+func IDRanges(rng *rand.Rand, n, maxWidth int) string {
+	parts := make([]string, n)
+	for i := range parts {
+		start := 1 + rng.IntN(9_000_000_000)
+		parts[i] = fmt.Sprintf("%d-%d", start, start+rng.IntN(maxWidth+1))
+	}
+	return strings.Join(parts, ",") + "\n"
+}
+
+// day 3: n banks of length batteries rated 1 to 9
+func BatteryBanks(rng *rand.Rand, n, length int) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		for j := 0; j < length; j++ {
+			b.WriteByte(byte('1' + rng.IntN(9)))
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// day 4: a rows by cols grid where each cell is a roll (@) with
+// probability density, otherwise empty (.)
+func RollGrid(rng *rand.Rand, rows, cols int, density float64) string {
+	var b strings.Builder
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			if rng.Float64() < density {
+				b.WriteByte('@')
+			} else {
+				b.WriteByte('.')
+			}
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// day 5: a database of fresh ranges, a blank line and available IDs,
+// with IDs up to 15 digits and ranges that may overlap
+func Inventory(rng *rand.Rand, ranges, ids int) string {
+	const maxID = 500_000_000_000_000
+
+	var b strings.Builder
+	for i := 0; i < ranges; i++ {
+		start := 1 + rng.Int64N(maxID)
+		fmt.Fprintf(&b, "%d-%d\n", start, start+rng.Int64N(maxID/100))
+	}
+	b.WriteByte('\n')
+	for i := 0; i < ids; i++ {
+		fmt.Fprintf(&b, "%d\n", 1+rng.Int64N(maxID))
+	}
+	return b.String()
+}
+
+// day 6: a worksheet of problems side by side, each with rows numbers
+// of up to four digits aligned left or right in its columns and the
+// operator under its first column
+func Worksheet(rng *rand.Rand, problems, rows int) string {
+	lines := make([]strings.Builder, rows+1)
+	for i := 0; i < problems; i++ {
+		if i > 0 {
+			for r := range lines {
+				lines[r].WriteByte(' ')
+			}
+		}
+
+		width := 1 + rng.IntN(4)
+		left := rng.IntN(2) == 1
+		// one number fills the width so no column inside the problem
+		// is blank, which would split it in two
+		full := rng.IntN(rows)
+		for r := 0; r < rows; r++ {
+			digits := width
+			if r != full {
+				digits = 1 + rng.IntN(width)
+			}
+			num := strconv.Itoa(1 + rng.IntN(9))
+			for len(num) < digits {
+				num += strconv.Itoa(rng.IntN(10))
+			}
+
+			pad := strings.Repeat(" ", width-digits)
+			if left {
+				lines[r].WriteString(num + pad)
+			} else {
+				lines[r].WriteString(pad + num)
+			}
+		}
+
+		op := "+"
+		if rng.IntN(2) == 1 {
+			op = "*"
+		}
+		lines[rows].WriteString(op + strings.Repeat(" ", width-1))
+	}
+
+	var b strings.Builder
+	for r := range lines {
+		b.WriteString(lines[r].String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
@@ -0,0 +1,138 @@
+/**
+ * Test suite for Advent of Code 2025 - Synthetic Inputs
+ *
+ * Tests verify generation is deterministic and sized as requested.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package gen
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// same seed, same input; different seed, different input
+func TestDayDeterministic(t *testing.T) {
+	for day := range DefaultSizes {
+		a, err := Day(day, 7, 0)
+		if err != nil {
+			t.Fatalf("Day(%d) failed: %v", day, err)
+		}
+		b, _ := Day(day, 7, 0)
+		c, _ := Day(day, 8, 0)
+
+		if a != b {
+			t.Errorf("day %d: same seed gave different inputs", day)
+		}
+		if a == c {
+			t.Errorf("day %d: different seeds gave the same input", day)
+		}
+	}
+}
+
+// unknown day and bad size
+func TestDayInvalid(t *testing.T) {
+	if _, err := Day(26, 1, 0); err == nil {
+		t.Errorf("Day(26) expected error but got none")
+	}
+	if _, err := Day(1, 1, -1); err == nil {
+		t.Errorf("Day(1) with negative size expected error but got none")
+	}
+}
+
+// each line matches the day's format
+func TestFormats(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		lines   int
+		pattern string
+	}{
+		{"rotations", Rotations(New(1), 50, 999), 50, `^[LR][1-9]\d{0,2}$`},
+		{"ranges", IDRanges(New(1), 10, 100), 1, `^(\d+-\d+,){9}\d+-\d+$`},
+		{"banks", BatteryBanks(New(1), 20, 100), 20, `^[1-9]{100}$`},
+		{"grid", RollGrid(New(1), 30, 40, 0.5), 30, `^[@.]{40}$`},
+	}
+
+	for _, tt := range tests {
+		lines := strings.Split(strings.TrimSuffix(tt.input, "\n"), "\n")
+		if len(lines) != tt.lines {
+			t.Errorf("%s: %d lines; expected %d", tt.name, len(lines), tt.lines)
+		}
+		re := regexp.MustCompile(tt.pattern)
+		for _, line := range lines {
+			if !re.MatchString(line) {
+				t.Errorf("%s: line %q does not match %s", tt.name, line, tt.pattern)
+				break
+			}
+		}
+	}
+}
+
+// ranges never run backwards
+func TestIDRangesOrdered(t *testing.T) {
+	input := strings.TrimSpace(IDRanges(New(3), 100, 1000))
+	for _, r := range strings.Split(input, ",") {
+		var start, end int
+		if _, err := fmt.Sscanf(r, "%d-%d", &start, &end); err != nil {
+			t.Fatalf("bad range %q: %v", r, err)
+		}
+		if start > end || end-start > 1000 {
+			t.Errorf("range %q out of bounds", r)
+		}
+	}
+}
+
+// database has the requested ranges, a blank line and the IDs
+func TestInventoryShape(t *testing.T) {
+	ranges, ids, ok := strings.Cut(Inventory(New(1), 12, 34), "\n\n")
+	if !ok {
+		t.Fatalf("Inventory has no blank separator line")
+	}
+	if n := len(strings.Split(ranges, "\n")); n != 12 {
+		t.Errorf("Inventory has %d ranges; expected 12", n)
+	}
+	if n := len(strings.Split(strings.TrimSuffix(ids, "\n"), "\n")); n != 34 {
+		t.Errorf("Inventory has %d IDs; expected 34", n)
+	}
+}
+
+// worksheet columns split into exactly the requested problems
+func TestWorksheetShape(t *testing.T) {
+	lines := strings.Split(strings.TrimSuffix(Worksheet(New(1), 25, 4), "\n"), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("Worksheet has %d lines; expected 5", len(lines))
+	}
+
+	width := len(lines[0])
+	for _, line := range lines {
+		if len(line) != width {
+			t.Fatalf("Worksheet lines have different widths")
+		}
+	}
+
+	// blank columns separate problems, so they must be one fewer
+	blank := 0
+	for col := 0; col < width; col++ {
+		empty := true
+		for _, line := range lines {
+			if line[col] != ' ' {
+				empty = false
+			}
+		}
+		if empty {
+			blank++
+		}
+	}
+	if blank != 24 {
+		t.Errorf("Worksheet has %d blank columns; expected 24", blank)
+	}
+	if ops := strings.Count(lines[4], "+") + strings.Count(lines[4], "*"); ops != 25 {
+		t.Errorf("Worksheet has %d operators; expected 25", ops)
+	}
+}
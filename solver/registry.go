@@ -0,0 +1,71 @@
+/**
+ * Advent of Code 2025 - Solver Registry
+ *
+ * Days register their Solver from an init function, so importing
+ * a day's package is enough to make it available to tooling.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package solver
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[int]Solver)
+)
+
+// adds a day's solver, panicking on nil or duplicate days
+// like database/sql.Register, since both are programming errors
+func Register(s Solver) {
+	if s == nil {
+		panic("solver: Register solver is nil")
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, dup := registry[s.Day()]; dup {
+		panic(fmt.Sprintf("solver: Register called twice for day %d", s.Day()))
+	}
+	registry[s.Day()] = s
+}
+
+// returns the solver registered for day
+func Lookup(day int) (Solver, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	s, ok := registry[day]
+	return s, ok
+}
+
+// returns every registered solver ordered by day
+func All() []Solver {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	solvers := make([]Solver, 0, len(registry))
+	for _, s := range registry {
+		solvers = append(solvers, s)
+	}
+	sort.Slice(solvers, func(i, j int) bool {
+		return solvers[i].Day() < solvers[j].Day()
+	})
+
+	return solvers
+}
+
+// removes a registered day, used by tests to keep the registry clean
+func unregister(day int) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	delete(registry, day)
+}
@@ -0,0 +1,60 @@
+/**
+ * Test suite for Advent of Code 2025 - Solver Registry
+ *
+ * Tests verify registration, lookup ordering and duplicate detection.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package solver
+
+import "testing"
+
+// register and lookup
+func TestRegisterLookup(t *testing.T) {
+	Register(fakeSolver{day: 102})
+	Register(fakeSolver{day: 101})
+	defer unregister(101)
+	defer unregister(102)
+
+	s, ok := Lookup(101)
+	if !ok || s.Day() != 101 {
+		t.Errorf("Lookup(101) = %v, %v; expected day 101", s, ok)
+	}
+
+	if _, ok := Lookup(103); ok {
+		t.Errorf("Lookup(103) found a solver; expected none")
+	}
+
+	all := All()
+	if len(all) != 2 {
+		t.Fatalf("All() returned %d solvers; expected 2", len(all))
+	}
+	if all[0].Day() != 101 || all[1].Day() != 102 {
+		t.Errorf("All() days = %d, %d; expected 101, 102", all[0].Day(), all[1].Day())
+	}
+}
+
+// duplicate register
+func TestRegisterDuplicatePanics(t *testing.T) {
+	Register(fakeSolver{day: 104})
+	defer unregister(104)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Register of duplicate day expected panic but got none")
+		}
+	}()
+	Register(fakeSolver{day: 104})
+}
+
+// nil register
+func TestRegisterNilPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Register(nil) expected panic but got none")
+		}
+	}()
+	Register(nil)
+}
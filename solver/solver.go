@@ -0,0 +1,75 @@
+/**
+ * Advent of Code 2025 - Shared Solver Interface
+ *
+ * Common shape every day implements so tooling can parse input
+ * and solve either part without knowing the day's own types.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package solver
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+)
+
+// Answer is a puzzle result rendered exactly as it would be submitted
+type Answer interface {
+	String() string
+}
+
+// Int is an answer that fits in 64 bits
+type Int int64
+
+func (i Int) String() string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+// BigInt is an answer that needs arbitrary precision
+type BigInt struct {
+	*big.Int
+}
+
+func (b BigInt) String() string {
+	if b.Int == nil {
+		return "0"
+	}
+	return b.Int.String()
+}
+
+// Puzzle is a parsed input that can solve both parts
+type Puzzle interface {
+	Part1() (Answer, error)
+	Part2() (Answer, error)
+}
+
+// Solver turns a day's raw input into a Puzzle
+type Solver interface {
+	Day() int
+	Title() string
+	Parse(r io.Reader) (Puzzle, error)
+}
+
+// solves part 1 or 2 of an already parsed puzzle
+func Part(p Puzzle, part int) (Answer, error) {
+	switch part {
+	case 1:
+		return p.Part1()
+	case 2:
+		return p.Part2()
+	}
+	return nil, fmt.Errorf("invalid part %d (expected 1 or 2)", part)
+}
+
+// parses input from r and solves the requested part
+func Solve(s Solver, r io.Reader, part int) (Answer, error) {
+	p, err := s.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("day %d: %w", s.Day(), err)
+	}
+	return Part(p, part)
+}
@@ -0,0 +1,134 @@
+/**
+ * Test suite for Advent of Code 2025 - Shared Solver Interface
+ *
+ * Tests verify answer rendering and part dispatch.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package solver
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// fakeSolver sums (p1) or multiplies (p2) one number per line
+type fakeSolver struct {
+	day int
+}
+
+type fakePuzzle []int64
+
+func (f fakeSolver) Day() int      { return f.day }
+func (f fakeSolver) Title() string { return "Fake" }
+
+func (f fakeSolver) Parse(r io.Reader) (Puzzle, error) {
+	var nums fakePuzzle
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		n, err := strconv.ParseInt(strings.TrimSpace(scanner.Text()), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		nums = append(nums, n)
+	}
+	return nums, scanner.Err()
+}
+
+func (p fakePuzzle) Part1() (Answer, error) {
+	var sum int64
+	for _, n := range p {
+		sum += n
+	}
+	return Int(sum), nil
+}
+
+func (p fakePuzzle) Part2() (Answer, error) {
+	prod := big.NewInt(1)
+	for _, n := range p {
+		prod.Mul(prod, big.NewInt(n))
+	}
+	return BigInt{prod}, nil
+}
+
+// answer rendering
+func TestAnswerString(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	tests := []struct {
+		answer   Answer
+		expected string
+	}{
+		{Int(0), "0"},
+		{Int(42), "42"},
+		{Int(-7), "-7"},
+		{Int(4805473544166), "4805473544166"},
+		{BigInt{huge}, "123456789012345678901234567890"},
+		{BigInt{}, "0"},
+	}
+
+	for _, test := range tests {
+		if result := test.answer.String(); result != test.expected {
+			t.Errorf("%#v.String() = %q; expected %q", test.answer, result, test.expected)
+		}
+	}
+}
+
+// part dispatch
+func TestPart(t *testing.T) {
+	p := fakePuzzle{2, 3, 4}
+
+	tests := []struct {
+		part     int
+		expected string
+		hasError bool
+	}{
+		{1, "9", false},
+		{2, "24", false},
+		{0, "", true},
+		{3, "", true},
+	}
+
+	for _, test := range tests {
+		result, err := Part(p, test.part)
+
+		if test.hasError {
+			if err == nil {
+				t.Errorf("Part(%d) expected error but got none", test.part)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Part(%d) unexpected error: %v", test.part, err)
+			continue
+		}
+		if result.String() != test.expected {
+			t.Errorf("Part(%d) = %s; expected %s", test.part, result, test.expected)
+		}
+	}
+}
+
+// parse and solve
+func TestSolve(t *testing.T) {
+	result, err := Solve(fakeSolver{day: 1}, strings.NewReader("5\n6\n7\n"), 1)
+	if err != nil {
+		t.Fatalf("Solve unexpected error: %v", err)
+	}
+	if result.String() != "18" {
+		t.Errorf("Solve() = %s; expected 18", result)
+	}
+
+	_, err = Solve(fakeSolver{day: 1}, strings.NewReader("5\nx\n"), 1)
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Errorf("Solve() with bad input error = %v; expected wrapped *strconv.NumError", err)
+	}
+}
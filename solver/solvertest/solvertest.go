@@ -0,0 +1,113 @@
+/**
+ * Advent of Code 2025 - Solver Test Helpers
+ *
+ * Runs solvers against the example fixtures extracted from the
+ * problem statements, so every stated answer doubles as a test,
+ * and benchmarks them the same way for every day.
+ *
+ * Author: KleaSCM
+ * Email: KleaSCM@gmail.com
+ */
+
+package solvertest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"solver"
+	"solver/example"
+)
+
+// checks s against each example's stated answers, one subtest per
+// example and part
+func Run(t *testing.T, s solver.Solver, examples []example.Example) {
+	t.Helper()
+
+	for _, e := range examples {
+		t.Run(e.Name, func(t *testing.T) {
+			p, err := s.Parse(strings.NewReader(e.Input))
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+
+			for _, part := range e.Parts() {
+				t.Run(fmt.Sprintf("part%d", part), func(t *testing.T) {
+					got, err := solver.Part(p, part)
+					if err != nil {
+						t.Fatalf("Part(%d) failed: %v", part, err)
+					}
+					if got.String() != e.Answers[part] {
+						t.Errorf("Day %d part %d = %s; expected %s", s.Day(), part, got, e.Answers[part])
+					}
+				})
+			}
+		})
+	}
+}
+
+// checks s against the fixtures in dir, failing if there are none
+func RunDir(t *testing.T, s solver.Solver, dir string) {
+	t.Helper()
+
+	examples, err := example.Load(dir)
+	if err != nil {
+		t.Fatalf("Failed to load examples: %v", err)
+	}
+	if len(examples) == 0 {
+		t.Fatalf("no example fixtures in %s", dir)
+	}
+
+	Run(t, s, examples)
+}
+
+// checks every registered solver against its day's fixtures under
+// the repository root
+func RunAll(t *testing.T, root string) {
+	t.Helper()
+
+	solvers := solver.All()
+	if len(solvers) == 0 {
+		t.Fatalf("no solvers registered")
+	}
+
+	for _, s := range solvers {
+		t.Run(fmt.Sprintf("day%d", s.Day()), func(t *testing.T) {
+			RunDir(t, s, example.Dir(root, s.Day()))
+		})
+	}
+}
+
+// benchmarks parsing plus solving each part of input, as sub-benchmarks
+// named name/part1 and name/part2
+func Bench(b *testing.B, s solver.Solver, name, input string) {
+	b.Helper()
+
+	for _, part := range []int{1, 2} {
+		b.Run(fmt.Sprintf("%s/part%d", name, part), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(input)))
+
+			for b.Loop() {
+				if _, err := solver.Solve(s, strings.NewReader(input), part); err != nil {
+					b.Fatalf("Solve failed: %v", err)
+				}
+			}
+		})
+	}
+}
+
+// benchmarks s on each fixture in dir like Bench, named after the
+// fixture file
+func BenchDir(b *testing.B, s solver.Solver, dir string) {
+	b.Helper()
+
+	examples, err := example.Load(dir)
+	if err != nil {
+		b.Fatalf("Failed to load examples: %v", err)
+	}
+	for _, e := range examples {
+		Bench(b, s, strings.TrimSuffix(e.Name, ".txt"), e.Input)
+	}
+}